@@ -0,0 +1,111 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to modify or
+// otherwise use the software for commercial activities involving the Arduino
+// software without disclosing the source code of your own applications. To purchase
+// a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"sync"
+
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// CompilationDatabase keeps track of the commands used to compile the source
+// files of a build, in the format of the Clang JSON compilation database
+// (https://clang.llvm.org/docs/JSONCompilationDatabase.html)
+type CompilationDatabase struct {
+	Contents []CompilationCommand
+	File     *paths.Path
+	mux      sync.Mutex
+}
+
+// CompilationCommand is the command used to compile a single source file
+type CompilationCommand struct {
+	Directory string   `json:"directory"`
+	Arguments []string `json:"arguments"`
+	File      string   `json:"file"`
+}
+
+// NewCompilationDatabase creates an empty compilation database that will be
+// saved in the given file
+func NewCompilationDatabase(file *paths.Path) *CompilationDatabase {
+	return &CompilationDatabase{
+		File:     file,
+		Contents: []CompilationCommand{},
+	}
+}
+
+// LoadCompilationDatabase reads a compilation database from a file
+func LoadCompilationDatabase(file *paths.Path) (*CompilationDatabase, error) {
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf("reading compilation database: %s", err)
+	}
+	db := NewCompilationDatabase(file)
+	if err := json.Unmarshal(data, &db.Contents); err != nil {
+		return nil, fmt.Errorf("parsing compilation database: %s", err)
+	}
+	return db, nil
+}
+
+// Add records the command used to compile the given source file, replacing
+// any previous command for the same file. It's safe to call it from the
+// parallel compile jobs.
+func (db *CompilationDatabase) Add(source *paths.Path, command *exec.Cmd) {
+	dir := command.Dir
+	if dir == "" {
+		// the command runs in the current working directory
+		if wd, err := os.Getwd(); err == nil {
+			dir = wd
+		}
+	}
+	entry := CompilationCommand{
+		Directory: dir,
+		Arguments: append([]string{}, command.Args...),
+		File:      source.String(),
+	}
+
+	db.mux.Lock()
+	defer db.mux.Unlock()
+	for i, c := range db.Contents {
+		if c.File == entry.File {
+			db.Contents[i] = entry
+			return
+		}
+	}
+	db.Contents = append(db.Contents, entry)
+}
+
+// SaveToFile writes the compilation database to its file, the commands are
+// sorted by source file to produce a stable output
+func (db *CompilationDatabase) SaveToFile() error {
+	db.mux.Lock()
+	defer db.mux.Unlock()
+	sort.SliceStable(db.Contents, func(i, j int) bool {
+		return db.Contents[i].File < db.Contents[j].File
+	})
+	data, err := json.MarshalIndent(db.Contents, "", " ")
+	if err != nil {
+		return fmt.Errorf("encoding compilation database: %s", err)
+	}
+	if err := db.File.WriteFile(data); err != nil {
+		return fmt.Errorf("writing compilation database: %s", err)
+	}
+	return nil
+}
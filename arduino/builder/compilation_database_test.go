@@ -0,0 +1,47 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to modify or
+// otherwise use the software for commercial activities involving the Arduino
+// software without disclosing the source code of your own applications. To purchase
+// a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"os/exec"
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompilationDatabase(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "compilation-database-test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	db := NewCompilationDatabase(tmp.Join("compile_commands.json"))
+	db.Add(paths.New("/sketch/b.cpp"), &exec.Cmd{Args: []string{"gcc", "-c", "/sketch/b.cpp"}, Dir: "/build"})
+	db.Add(paths.New("/sketch/a.cpp"), &exec.Cmd{Args: []string{"gcc", "-c", "/sketch/a.cpp"}, Dir: "/build"})
+	db.Add(paths.New("/sketch/b.cpp"), &exec.Cmd{Args: []string{"gcc", "-Os", "-c", "/sketch/b.cpp"}, Dir: "/build"})
+	require.Len(t, db.Contents, 2)
+	require.NoError(t, db.SaveToFile())
+
+	loaded, err := LoadCompilationDatabase(db.File)
+	require.NoError(t, err)
+	require.Equal(t, []CompilationCommand{
+		{Directory: "/build", Arguments: []string{"gcc", "-c", "/sketch/a.cpp"}, File: "/sketch/a.cpp"},
+		{Directory: "/build", Arguments: []string{"gcc", "-Os", "-c", "/sketch/b.cpp"}, File: "/sketch/b.cpp"},
+	}, loaded.Contents)
+
+	_, err = LoadCompilationDatabase(tmp.Join("missing.json"))
+	require.Error(t, err)
+}
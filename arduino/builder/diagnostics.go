@@ -0,0 +1,146 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to modify or
+// otherwise use the software for commercial activities involving the Arduino
+// software without disclosing the source code of your own applications. To purchase
+// a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Diagnostic is an error, a warning or a note emitted by the compiler
+type Diagnostic struct {
+	File     string        `json:"file"`
+	Line     int           `json:"line"`
+	Column   int           `json:"column,omitempty"`
+	Severity string        `json:"severity"` // "error", "warning", "note" or "info"
+	Message  string        `json:"message"`
+	Notes    []*Diagnostic `json:"notes,omitempty"`
+}
+
+// gcc diagnostics have the form "FILE:LINE:COLUMN: SEVERITY: MESSAGE", the
+// column is omitted for some messages
+var gccDiagnosticRe = regexp.MustCompile(`^(.+?):(\d+):(?:(\d+):)? (fatal error|error|warning|note|info): (.*)$`)
+
+// ParseGccDiagnostics extracts the diagnostics from the output of gcc. The
+// notes are attached to the preceding error or warning.
+func ParseGccDiagnostics(output []byte) []*Diagnostic {
+	res := []*Diagnostic{}
+	var last *Diagnostic
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		m := gccDiagnosticRe.FindStringSubmatch(strings.TrimRight(scanner.Text(), "\r"))
+		if m == nil {
+			continue
+		}
+		line, _ := strconv.Atoi(m[2])
+		column, _ := strconv.Atoi(m[3])
+		diagnostic := &Diagnostic{
+			File:     m[1],
+			Line:     line,
+			Column:   column,
+			Severity: m[4],
+			Message:  m[5],
+		}
+		if diagnostic.Severity == "fatal error" {
+			diagnostic.Severity = "error"
+		}
+		if diagnostic.Severity == "note" && last != nil {
+			last.Notes = append(last.Notes, diagnostic)
+			continue
+		}
+		res = append(res, diagnostic)
+		last = diagnostic
+	}
+	return res
+}
+
+// lineDirectiveRe matches the #line directives, e.g.: #line 12 "/path/sketch.ino"
+var lineDirectiveRe = regexp.MustCompile(`^\s*#\s*line\s+(\d+)(?:\s+("(?:[^"\\]|\\.)*"))?`)
+
+// SourceMapper maps the lines of a preprocessed source file, like the
+// .ino.cpp produced by merging the sketch files, to the original files
+// using the #line directives in the preprocessed source
+type SourceMapper struct {
+	file  string
+	lines []sourceLocation
+}
+
+type sourceLocation struct {
+	file string
+	line int
+}
+
+// NewSourceMapper creates a SourceMapper for the given preprocessed source
+func NewSourceMapper(file string, source []byte) *SourceMapper {
+	m := &SourceMapper{file: file}
+	current := sourceLocation{file: file, line: 1}
+	scanner := bufio.NewScanner(bytes.NewReader(source))
+	scanner.Buffer(nil, len(source)+1)
+	for scanner.Scan() {
+		text := scanner.Text()
+		m.lines = append(m.lines, current)
+		if d := lineDirectiveRe.FindStringSubmatch(text); d != nil {
+			// the line following the directive has the given number
+			current.line, _ = strconv.Atoi(d[1])
+			if d[2] != "" {
+				current.file = unquoteCppString(d[2])
+			}
+			continue
+		}
+		current.line++
+	}
+	return m
+}
+
+// Map returns the original file and line of the given line of the
+// preprocessed source
+func (m *SourceMapper) Map(line int) (string, int) {
+	if line < 1 || line > len(m.lines) {
+		return m.file, line
+	}
+	location := m.lines[line-1]
+	return location.file, location.line
+}
+
+// MapDiagnostics replaces, in place, the locations in the preprocessed
+// source with the corresponding original locations
+func (m *SourceMapper) MapDiagnostics(diagnostics []*Diagnostic) {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.File == m.file {
+			diagnostic.File, diagnostic.Line = m.Map(diagnostic.Line)
+		}
+		m.MapDiagnostics(diagnostic.Notes)
+	}
+}
+
+// unquoteCppString is the inverse of QuoteCppString
+func unquoteCppString(str string) string {
+	str = strings.TrimSuffix(strings.TrimPrefix(str, `"`), `"`)
+	res := strings.Builder{}
+	escaped := false
+	for _, c := range str {
+		if c == '\\' && !escaped {
+			escaped = true
+			continue
+		}
+		escaped = false
+		res.WriteRune(c)
+	}
+	return res.String()
+}
@@ -0,0 +1,90 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to modify or
+// otherwise use the software for commercial activities involving the Arduino
+// software without disclosing the source code of your own applications. To purchase
+// a commercial license, send an email to license@arduino.cc.
+
+package builder_test
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/builder"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGccDiagnostics(t *testing.T) {
+	output := `/tmp/build/sketch/Blink.ino.cpp: In function 'void loop()':
+/tmp/build/sketch/Blink.ino.cpp:12:3: error: 'foo' was not declared in this scope
+   foo();
+   ^~~
+/tmp/build/sketch/Blink.ino.cpp:12:3: note: suggested alternative: 'for'
+C:\Users\me\Blink\Blink.ino:3:1: warning: unused variable 'x' [-Wunused-variable]
+/tmp/build/sketch/Blink.ino.cpp:1:10: fatal error: Missing.h: No such file or directory
+compilation terminated.
+`
+	diagnostics := builder.ParseGccDiagnostics([]byte(output))
+	require.Len(t, diagnostics, 3)
+	require.Equal(t, &builder.Diagnostic{
+		File:     "/tmp/build/sketch/Blink.ino.cpp",
+		Line:     12,
+		Column:   3,
+		Severity: "error",
+		Message:  "'foo' was not declared in this scope",
+		Notes: []*builder.Diagnostic{{
+			File:     "/tmp/build/sketch/Blink.ino.cpp",
+			Line:     12,
+			Column:   3,
+			Severity: "note",
+			Message:  "suggested alternative: 'for'",
+		}},
+	}, diagnostics[0])
+	require.Equal(t, `C:\Users\me\Blink\Blink.ino`, diagnostics[1].File)
+	require.Equal(t, "warning", diagnostics[1].Severity)
+	require.Equal(t, "error", diagnostics[2].Severity)
+	require.Equal(t, "Missing.h: No such file or directory", diagnostics[2].Message)
+}
+
+func TestSourceMapper(t *testing.T) {
+	source := `#include <Arduino.h>
+#line 1 "/home/me/Blink/Blink.ino"
+void setup() {
+}
+#line 1 "/home/me/Blink/Other \"file\".ino"
+void loop() {
+  foo();
+}
+`
+	mapper := builder.NewSourceMapper("/tmp/build/sketch/Blink.ino.cpp", []byte(source))
+	file, line := mapper.Map(1)
+	require.Equal(t, "/tmp/build/sketch/Blink.ino.cpp", file)
+	require.Equal(t, 1, line)
+	file, line = mapper.Map(4)
+	require.Equal(t, "/home/me/Blink/Blink.ino", file)
+	require.Equal(t, 2, line)
+	file, line = mapper.Map(7)
+	require.Equal(t, `/home/me/Blink/Other "file".ino`, file)
+	require.Equal(t, 2, line)
+
+	diagnostics := []*builder.Diagnostic{
+		{File: "/tmp/build/sketch/Blink.ino.cpp", Line: 7, Severity: "error",
+			Notes: []*builder.Diagnostic{{File: "/tmp/build/sketch/Blink.ino.cpp", Line: 3, Severity: "note"}}},
+		{File: "/home/me/Blink/lib.h", Line: 7, Severity: "warning"},
+	}
+	mapper.MapDiagnostics(diagnostics)
+	require.Equal(t, `/home/me/Blink/Other "file".ino`, diagnostics[0].File)
+	require.Equal(t, 2, diagnostics[0].Line)
+	require.Equal(t, "/home/me/Blink/Blink.ino", diagnostics[0].Notes[0].File)
+	require.Equal(t, 1, diagnostics[0].Notes[0].Line)
+	require.Equal(t, "/home/me/Blink/lib.h", diagnostics[1].File)
+	require.Equal(t, 7, diagnostics[1].Line)
+}
@@ -0,0 +1,378 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to modify or
+// otherwise use the software for commercial activities involving the Arduino
+// software without disclosing the source code of your own applications. To purchase
+// a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// ObjectCache is a content addressed cache of compiled object files shared
+// across build paths. An object is looked up by a key computed from the
+// source file content and from the compile command, the object is then used
+// only if the headers it depends on did not change since it was stored.
+//
+// The cache directory contains the object files, named after the hash of
+// their content, and the entries describing how they were compiled:
+//
+//	objects/<sha256 of the object file>
+//	entries/<key>.json
+type ObjectCache struct {
+	Dir     *paths.Path
+	MaxSize int64 // in bytes, 0 means unlimited
+
+	hashes    map[string]*cachedFileHash
+	hashesMux sync.Mutex
+}
+
+// ObjectCacheStats are the statistics of an ObjectCache
+type ObjectCacheStats struct {
+	Dir     string `json:"dir"`
+	Entries int    `json:"entries"`
+	Objects int    `json:"objects"`
+	Size    int64  `json:"size"`
+	MaxSize int64  `json:"max_size"`
+}
+
+type objectCacheEntry struct {
+	Object       string            `json:"object"`
+	DepFile      string            `json:"depfile"`
+	Dependencies map[string]string `json:"dependencies"`
+	// Stderr is the output of the compiler, replayed when the object is
+	// restored to not lose the warnings
+	Stderr string `json:"stderr,omitempty"`
+}
+
+type cachedFileHash struct {
+	modTime time.Time
+	size    int64
+	hash    string
+}
+
+// buildPathPlaceholder replaces the build path in the compile commands and
+// in the dependency files, so the same sources compiled in different build
+// paths share the cache entries
+const buildPathPlaceholder = "{build.path}"
+
+// NewObjectCache returns the object cache stored in the given directory
+func NewObjectCache(dir *paths.Path, maxSize int64) *ObjectCache {
+	return &ObjectCache{
+		Dir:     dir,
+		MaxSize: maxSize,
+		hashes:  map[string]*cachedFileHash{},
+	}
+}
+
+// Key computes the cache key of the object file compiled from source with
+// the given command line. The include paths and all the flags are part of
+// the command line.
+func (c *ObjectCache) Key(source *paths.Path, args []string, buildPath *paths.Path) (string, error) {
+	sourceHash, err := c.fileHash(source)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	fmt.Fprintln(h, "arduino-object-cache-v2")
+	fmt.Fprintln(h, sourceHash)
+	for _, arg := range args {
+		fmt.Fprintf(h, "%s\x00", normalizeBuildPath(arg, buildPath))
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Restore copies the cached object file and its dependency file in the build
+// path and returns the output of the compiler when the object was stored. It
+// returns false if there is no valid entry for the key.
+func (c *ObjectCache) Restore(key string, objectFile, depFile, buildPath *paths.Path) (bool, []byte, error) {
+	entryFile := c.Dir.Join("entries", key+".json")
+	data, err := entryFile.ReadFile()
+	if os.IsNotExist(err) {
+		return false, nil, nil
+	} else if err != nil {
+		return false, nil, fmt.Errorf("reading cache entry: %s", err)
+	}
+	var entry objectCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		// a corrupted entry is simply overwritten by the next Store
+		return false, nil, nil
+	}
+
+	for dep, hash := range entry.Dependencies {
+		depPath := paths.New(expandBuildPath(dep, buildPath))
+		if current, err := c.fileHash(depPath); err != nil || current != hash {
+			return false, nil, nil
+		}
+	}
+
+	object := c.Dir.Join("objects", entry.Object)
+	if err := objectFile.Parent().MkdirAll(); err != nil {
+		return false, nil, err
+	}
+	if err := object.CopyTo(objectFile); os.IsNotExist(err) {
+		// the object has been evicted
+		return false, nil, nil
+	} else if err != nil {
+		return false, nil, fmt.Errorf("copying cached object: %s", err)
+	}
+	if err := depFile.WriteFile([]byte(expandBuildPath(entry.DepFile, buildPath))); err != nil {
+		return false, nil, fmt.Errorf("writing dependency file: %s", err)
+	}
+
+	// the modification time is used to evict the least recently used files
+	now := time.Now()
+	os.Chtimes(entryFile.String(), now, now)
+	os.Chtimes(object.String(), now, now)
+	return true, []byte(expandBuildPath(entry.Stderr, buildPath)), nil
+}
+
+// Store adds the object file compiled with the given key to the cache,
+// together with the hashes of the files listed in its dependency file and
+// the output of the compiler
+func (c *ObjectCache) Store(key string, objectFile, depFile, buildPath *paths.Path, stderr []byte) error {
+	depData, err := depFile.ReadFile()
+	if err != nil {
+		return fmt.Errorf("reading dependency file: %s", err)
+	}
+	entry := &objectCacheEntry{
+		DepFile:      normalizeBuildPath(string(depData), buildPath),
+		Dependencies: map[string]string{},
+		Stderr:       normalizeBuildPath(string(stderr), buildPath),
+	}
+	for _, dep := range ParseDepFile(string(depData)) {
+		hash, err := c.fileHash(paths.New(dep))
+		if err != nil {
+			return fmt.Errorf("hashing dependency: %s", err)
+		}
+		entry.Dependencies[normalizeBuildPath(dep, buildPath)] = hash
+	}
+
+	if entry.Object, err = c.fileHash(objectFile); err != nil {
+		return err
+	}
+	object := c.Dir.Join("objects", entry.Object)
+	if !object.Exist() {
+		data, err := objectFile.ReadFile()
+		if err != nil {
+			return fmt.Errorf("reading object file: %s", err)
+		}
+		if err := writeFileAtomic(object, data); err != nil {
+			return fmt.Errorf("storing object file: %s", err)
+		}
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("encoding cache entry: %s", err)
+	}
+	if err := writeFileAtomic(c.Dir.Join("entries", key+".json"), data); err != nil {
+		return fmt.Errorf("storing cache entry: %s", err)
+	}
+	return nil
+}
+
+// Stats returns the number of entries and objects in the cache, and its size
+func (c *ObjectCache) Stats() (*ObjectCacheStats, error) {
+	stats := &ObjectCacheStats{Dir: c.Dir.String(), MaxSize: c.MaxSize}
+	files, err := c.files()
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file.path, ".json") {
+			stats.Entries++
+		} else {
+			stats.Objects++
+		}
+		stats.Size += file.size
+	}
+	return stats, nil
+}
+
+// Clean removes all the content of the cache
+func (c *ObjectCache) Clean() error {
+	if err := c.Dir.RemoveAll(); err != nil {
+		return fmt.Errorf("removing cache: %s", err)
+	}
+	return nil
+}
+
+// Trim removes the least recently used files until the size of the cache is
+// below MaxSize
+func (c *ObjectCache) Trim() error {
+	if c.MaxSize <= 0 {
+		return nil
+	}
+	files, err := c.files()
+	if err != nil {
+		return err
+	}
+	size := int64(0)
+	for _, file := range files {
+		size += file.size
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].modTime.Before(files[j].modTime)
+	})
+	for _, file := range files {
+		if size <= c.MaxSize {
+			break
+		}
+		if err := os.Remove(file.path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("removing cached file: %s", err)
+		}
+		size -= file.size
+	}
+	return nil
+}
+
+type objectCacheFile struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+func (c *ObjectCache) files() ([]*objectCacheFile, error) {
+	res := []*objectCacheFile{}
+	for _, dir := range []string{"entries", "objects"} {
+		infos, err := ioutil.ReadDir(c.Dir.Join(dir).String())
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("reading cache: %s", err)
+		}
+		for _, info := range infos {
+			if info.IsDir() || strings.HasPrefix(info.Name(), ".tmp") {
+				continue
+			}
+			res = append(res, &objectCacheFile{
+				path:    filepath.Join(c.Dir.Join(dir).String(), info.Name()),
+				size:    info.Size(),
+				modTime: info.ModTime(),
+			})
+		}
+	}
+	return res, nil
+}
+
+// fileHash returns the sha256 of the file content, the hashes are memoized
+// as long as the size and the modification time of the file don't change
+func (c *ObjectCache) fileHash(file *paths.Path) (string, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+	c.hashesMux.Lock()
+	cached := c.hashes[file.String()]
+	c.hashesMux.Unlock()
+	if cached != nil && cached.size == info.Size() && cached.modTime.Equal(info.ModTime()) {
+		return cached.hash, nil
+	}
+
+	data, err := file.ReadFile()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+
+	c.hashesMux.Lock()
+	c.hashes[file.String()] = &cachedFileHash{modTime: info.ModTime(), size: info.Size(), hash: hash}
+	c.hashesMux.Unlock()
+	return hash, nil
+}
+
+// writeFileAtomic writes the file through a temporary file, to not expose
+// partially written files to concurrent builds
+func writeFileAtomic(file *paths.Path, data []byte) error {
+	if err := file.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(file.Parent().String(), ".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), file.String())
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+func normalizeBuildPath(s string, buildPath *paths.Path) string {
+	return strings.Replace(s, buildPath.String(), buildPathPlaceholder, -1)
+}
+
+func expandBuildPath(s string, buildPath *paths.Path) string {
+	return strings.Replace(s, buildPathPlaceholder, buildPath.String(), -1)
+}
+
+// ParseDepFile returns the prerequisites listed in a make dependency file,
+// as the ones generated by gcc with the -MMD flag
+func ParseDepFile(content string) []string {
+	content = strings.Replace(content, "\\\r\n", " ", -1)
+	content = strings.Replace(content, "\\\n", " ", -1)
+
+	res := []string{}
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		// skip the target, separated by the first ": "
+		colon := strings.Index(line, ": ")
+		if colon == -1 {
+			if !strings.HasSuffix(line, ":") {
+				continue
+			}
+			colon = len(line) - 1
+		}
+		deps := line[colon+1:]
+		token := ""
+		for i := 0; i < len(deps); i++ {
+			switch ch := deps[i]; {
+			case ch == '\\' && i+1 < len(deps) && (deps[i+1] == ' ' || deps[i+1] == '#'):
+				// escaped space, the other backslashes are path separators
+				token += string(deps[i+1])
+				i++
+			case ch == ' ' || ch == '\t':
+				if token != "" {
+					res = append(res, token)
+					token = ""
+				}
+			default:
+				token += string(ch)
+			}
+		}
+		if token != "" {
+			res = append(res, token)
+		}
+	}
+	return res
+}
@@ -0,0 +1,108 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to modify or
+// otherwise use the software for commercial activities involving the Arduino
+// software without disclosing the source code of your own applications. To purchase
+// a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDepFile(t *testing.T) {
+	deps := ParseDepFile("/build/sketch/a.cpp.o: /build/sketch/a.cpp \\\n" +
+		" /libs/My\\ Lib/src/lib.h /core/Arduino.h \\\n" +
+		" C:\\core\\pins.h\n" +
+		"/libs/My\\ Lib/src/lib.h:\n")
+	require.Equal(t, []string{"/build/sketch/a.cpp", "/libs/My Lib/src/lib.h", "/core/Arduino.h", "C:\\core\\pins.h"}, deps)
+}
+
+func TestObjectCache(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "object-cache-test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	header := tmp.Join("lib", "lib.h")
+	source := tmp.Join("lib", "lib.cpp")
+	require.NoError(t, header.Parent().MkdirAll())
+	require.NoError(t, header.WriteFile([]byte("#define A 1")))
+	require.NoError(t, source.WriteFile([]byte("#include \"lib.h\"")))
+
+	// simulate a compilation in the first build path
+	build1 := tmp.Join("build1")
+	object1 := build1.Join("libraries", "lib", "lib.cpp.o")
+	depFile1 := build1.Join("libraries", "lib", "lib.cpp.d")
+	require.NoError(t, object1.Parent().MkdirAll())
+	require.NoError(t, object1.WriteFile([]byte("OBJECT")))
+	require.NoError(t, depFile1.WriteFile([]byte(object1.String()+": "+source.String()+" \\\n "+header.String()+"\n")))
+	args1 := []string{"g++", "-c", "-I" + tmp.Join("lib").String(), source.String(), "-o", object1.String()}
+
+	cache := NewObjectCache(tmp.Join("cache"), 0)
+	key1, err := cache.Key(source, args1, build1)
+	require.NoError(t, err)
+	restored, _, err := cache.Restore(key1, object1, depFile1, build1)
+	require.NoError(t, err)
+	require.False(t, restored)
+	stderr := source.String() + ":1:1: warning: unused variable 'a'\n" + object1.String() + ": note: in build path\n"
+	require.NoError(t, cache.Store(key1, object1, depFile1, build1, []byte(stderr)))
+
+	// the same command in another build path uses the cached object
+	build2 := tmp.Join("build2")
+	object2 := build2.Join("libraries", "lib", "lib.cpp.o")
+	depFile2 := build2.Join("libraries", "lib", "lib.cpp.d")
+	args2 := []string{"g++", "-c", "-I" + tmp.Join("lib").String(), source.String(), "-o", object2.String()}
+	key2, err := cache.Key(source, args2, build2)
+	require.NoError(t, err)
+	require.Equal(t, key1, key2)
+	restored, restoredStderr, err := cache.Restore(key2, object2, depFile2, build2)
+	require.NoError(t, err)
+	require.True(t, restored)
+	// the output of the compiler is restored too, relocated in the build path
+	require.Equal(t, source.String()+":1:1: warning: unused variable 'a'\n"+object2.String()+": note: in build path\n", string(restoredStderr))
+	data, err := object2.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "OBJECT", string(data))
+	data, err = depFile2.ReadFile()
+	require.NoError(t, err)
+	require.Contains(t, string(data), object2.String()+":")
+
+	// different flags produce a different key
+	key3, err := cache.Key(source, append(args2, "-O2"), build2)
+	require.NoError(t, err)
+	require.NotEqual(t, key1, key3)
+
+	// a changed header invalidates the entry
+	require.NoError(t, header.WriteFile([]byte("#define A 2")))
+	restored, _, err = cache.Restore(key2, object2, depFile2, build2)
+	require.NoError(t, err)
+	require.False(t, restored)
+
+	stats, err := cache.Stats()
+	require.NoError(t, err)
+	require.Equal(t, 1, stats.Entries)
+	require.Equal(t, 1, stats.Objects)
+	require.True(t, stats.Size > 0)
+
+	// trimming to a tiny size evicts everything
+	cache.MaxSize = 1
+	require.NoError(t, cache.Trim())
+	stats, err = cache.Stats()
+	require.NoError(t, err)
+	require.Equal(t, 0, stats.Entries+stats.Objects)
+
+	require.NoError(t, cache.Clean())
+	require.False(t, cache.Dir.Exist())
+}
@@ -0,0 +1,247 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"time"
+
+	"github.com/arduino/arduino-cli/executils"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Discovery is an external tool that detects the communication ports
+// available to interact with the boards. The tool is controlled through
+// a line-based protocol on stdin (LIST, START_SYNC, STOP, QUIT) and replies
+// with JSON messages on stdout.
+type Discovery struct {
+	ID   string
+	args []string
+}
+
+// Port is a communication port detected by a Discovery
+type Port struct {
+	Address             string          `json:"address"`
+	Label               string          `json:"label"`
+	Prefs               *properties.Map `json:"prefs"`
+	IdentificationPrefs *properties.Map `json:"identificationPrefs"`
+	Protocol            string          `json:"protocol"`
+	ProtocolLabel       string          `json:"protocolLabel"`
+}
+
+// UnmarshalJSON decodes a Port, the prefs are optional and default to
+// empty maps
+func (p *Port) UnmarshalJSON(data []byte) error {
+	type portJSON Port
+	res := portJSON{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+	if res.Prefs == nil {
+		res.Prefs = properties.NewMap()
+	}
+	if res.IdentificationPrefs == nil {
+		res.IdentificationPrefs = properties.NewMap()
+	}
+	*p = Port(res)
+	return nil
+}
+
+func (p *Port) String() string {
+	if p == nil {
+		return "none"
+	}
+	return p.Protocol + "://" + p.Address
+}
+
+// PortDiscovery is the interface implemented by all the discoveries,
+// either running as an external process or built into the CLI
+type PortDiscovery interface {
+	// GetID returns the unique identifier of the discovery
+	GetID() string
+	// List returns the ports currently detected
+	List() ([]*Port, error)
+	// Watch sends an event each time a port is connected or disconnected,
+	// until the context is cancelled
+	Watch(ctx context.Context) (<-chan *Event, error)
+}
+
+// Event is a notification sent by a Discovery running in sync mode
+// when a port is connected ("add") or disconnected ("remove")
+type Event struct {
+	Type string
+	Port *Port
+}
+
+type eventJSON struct {
+	EventType string  `json:"eventType,required"`
+	Ports     []*Port `json:"ports"`
+	Port      *Port   `json:"port"`
+}
+
+// New creates a new Discovery that runs the given command line
+func New(id string, args ...string) *Discovery {
+	return &Discovery{
+		ID:   id,
+		args: args,
+	}
+}
+
+func (d *Discovery) String() string {
+	return d.ID
+}
+
+// GetID returns the identifier of the discovery
+func (d *Discovery) GetID() string {
+	return d.ID
+}
+
+// List starts the discovery, sends the LIST command and returns the
+// ports detected. The discovery process is terminated before returning.
+func (d *Discovery) List() ([]*Port, error) {
+	cmd, in, out, err := d.start()
+	if err != nil {
+		return nil, err
+	}
+	outJSON := json.NewDecoder(out)
+
+	// send the LIST command
+	if _, err := in.Write([]byte("LIST\n")); err != nil {
+		d.stop(cmd, in, out)
+		return nil, fmt.Errorf("sending LIST command to discovery: %s", err)
+	}
+
+	// read the response from the pipe
+	decodeResult := make(chan error)
+	var event eventJSON
+	go func() {
+		decodeResult <- outJSON.Decode(&event)
+	}()
+
+	var finalError error
+	var retVal []*Port
+
+	// wait for the response
+	select {
+	case err := <-decodeResult:
+		if err == nil {
+			retVal = event.Ports
+		} else {
+			finalError = err
+		}
+	case <-time.After(10 * time.Second):
+		finalError = fmt.Errorf("decoding LIST command: timeout")
+	}
+
+	d.stop(cmd, in, out)
+	return retVal, finalError
+}
+
+// Watch starts the discovery in START_SYNC mode and sends an event on the
+// returned channel every time a port is connected or disconnected. The
+// discovery process is terminated and the channel is closed when the
+// context is cancelled.
+func (d *Discovery) Watch(ctx context.Context) (<-chan *Event, error) {
+	cmd, in, out, err := d.start()
+	if err != nil {
+		return nil, err
+	}
+	outJSON := json.NewDecoder(out)
+
+	// send the START_SYNC command
+	if _, err := in.Write([]byte("START_SYNC\n")); err != nil {
+		d.stop(cmd, in, out)
+		return nil, fmt.Errorf("sending START_SYNC command to discovery: %s", err)
+	}
+
+	events := make(chan *Event)
+	go func() {
+		defer close(events)
+		for {
+			var event eventJSON
+			if err := outJSON.Decode(&event); err != nil {
+				// the pipe is closed when the process is terminated
+				if ctx.Err() == nil {
+					logrus.WithField("discovery", d.ID).WithError(err).Error("Decoding event from discovery")
+				}
+				return
+			}
+			if event.Port == nil || (event.EventType != "add" && event.EventType != "remove") {
+				continue
+			}
+			select {
+			case events <- &Event{Type: event.EventType, Port: event.Port}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		in.Write([]byte("STOP\n"))
+		d.stop(cmd, in, out)
+	}()
+
+	return events, nil
+}
+
+// start runs the discovery tool and returns the process together with
+// the pipes connected to its stdin and stdout
+func (d *Discovery) start() (*exec.Cmd, io.WriteCloser, io.ReadCloser, error) {
+	cmd, err := executils.Command(d.args)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "creating discovery process")
+	}
+
+	// attach in/out pipes to the process
+	in, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("creating stdin pipe for discovery: %s", err)
+	}
+
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("creating stdout pipe for discovery: %s", err)
+	}
+
+	// start the process
+	if err := cmd.Start(); err != nil {
+		return nil, nil, nil, fmt.Errorf("starting discovery process: %s", err)
+	}
+	return cmd, in, out, nil
+}
+
+// stop tells the discovery process to quit and waits for its termination
+func (d *Discovery) stop(cmd *exec.Cmd, in io.WriteCloser, out io.ReadCloser) {
+	// tell the process to quit
+	in.Write([]byte("QUIT\n"))
+	in.Close()
+	out.Close()
+	// kill the process if it takes too long to quit
+	time.AfterFunc(time.Second, func() {
+		cmd.Process.Kill()
+	})
+	cmd.Wait()
+}
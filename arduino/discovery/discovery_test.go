@@ -0,0 +1,96 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDiscoveryScript is a minimal discovery that replies to LIST and
+// START_SYNC commands with a fixed set of ports
+const fakeDiscoveryScript = `
+while read cmd; do
+	case "$cmd" in
+	LIST)
+		echo '{ "eventType": "list", "ports": [ { "address": "/dev/ttyACM0", "protocol": "serial", "protocolLabel": "Serial Port (USB)" } ] }'
+		;;
+	START_SYNC)
+		echo '{ "eventType": "start_sync", "message": "OK" }'
+		echo '{ "eventType": "add", "port": { "address": "/dev/ttyACM0", "protocol": "serial" } }'
+		echo '{ "eventType": "remove", "port": { "address": "/dev/ttyACM0", "protocol": "serial" } }'
+		;;
+	QUIT)
+		exit 0
+		;;
+	esac
+done
+`
+
+func TestDiscoveryList(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires a POSIX shell")
+	}
+	disc := New("fake", "sh", "-c", fakeDiscoveryScript)
+	ports, err := disc.List()
+	require.NoError(t, err)
+	require.Len(t, ports, 1)
+	require.Equal(t, "/dev/ttyACM0", ports[0].Address)
+	require.Equal(t, "serial", ports[0].Protocol)
+	require.Equal(t, "Serial Port (USB)", ports[0].ProtocolLabel)
+	require.NotNil(t, ports[0].Prefs)
+	require.NotNil(t, ports[0].IdentificationPrefs)
+}
+
+func TestPortWithoutPrefs(t *testing.T) {
+	port := &Port{}
+	require.NoError(t, json.Unmarshal([]byte(`{ "address": "/dev/ttyACM0", "protocol": "serial", "prefs": null }`), port))
+	require.Equal(t, "/dev/ttyACM0", port.Address)
+	require.Equal(t, 0, port.Prefs.Size())
+	require.Equal(t, 0, port.IdentificationPrefs.Size())
+
+	require.NoError(t, json.Unmarshal([]byte(`{ "address": "/dev/ttyACM0", "identificationPrefs": { "vid": "0x2341" } }`), port))
+	require.Equal(t, "0x2341", port.IdentificationPrefs.Get("vid"))
+}
+
+func TestDiscoveryWatch(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires a POSIX shell")
+	}
+	disc := New("fake", "sh", "-c", fakeDiscoveryScript)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	events, err := disc.Watch(ctx)
+	require.NoError(t, err)
+
+	event := <-events
+	require.Equal(t, "add", event.Type)
+	require.Equal(t, "serial:///dev/ttyACM0", event.Port.String())
+	event = <-events
+	require.Equal(t, "remove", event.Type)
+
+	cancel()
+	for range events {
+		// wait for the channel to be closed
+	}
+}
@@ -0,0 +1,129 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package discoverymanager
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/arduino/arduino-cli/arduino/discovery"
+)
+
+// DiscoveryManager keeps the set of discoveries available in the system
+// and merges the results of all of them
+type DiscoveryManager struct {
+	discoveries map[string]discovery.PortDiscovery
+}
+
+// New creates a new DiscoveryManager without any discovery
+func New() *DiscoveryManager {
+	return &DiscoveryManager{
+		discoveries: map[string]discovery.PortDiscovery{},
+	}
+}
+
+// Add adds a discovery to the list of managed discoveries. An error is
+// returned if a discovery with the same ID is already present.
+func (dm *DiscoveryManager) Add(disc discovery.PortDiscovery) error {
+	id := disc.GetID()
+	if _, exists := dm.discoveries[id]; exists {
+		return fmt.Errorf("discovery %s already added", id)
+	}
+	dm.discoveries[id] = disc
+	return nil
+}
+
+// IDs returns the sorted list of the IDs of the managed discoveries
+func (dm *DiscoveryManager) IDs() []string {
+	ids := []string{}
+	for id := range dm.discoveries {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// List runs all the discoveries in parallel and returns the merged list
+// of ports detected. A discovery that fails doesn't prevent the others
+// from returning their ports: the errors are returned separately.
+func (dm *DiscoveryManager) List() ([]*discovery.Port, []error) {
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	res := []*discovery.Port{}
+	errs := []error{}
+	for _, id := range dm.IDs() {
+		disc := dm.discoveries[id]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ports, err := disc.List()
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("listing ports from discovery %s: %s", disc.GetID(), err))
+				return
+			}
+			res = append(res, ports...)
+		}()
+	}
+	wg.Wait()
+	return res, errs
+}
+
+// Watch starts all the discoveries in sync mode and merges their events in
+// the returned channel. The discoveries are stopped and the channel is
+// closed when the context is cancelled. The discoveries that can't be
+// started are ignored and the errors are returned separately: if no
+// discovery can be started the returned channel is nil.
+func (dm *DiscoveryManager) Watch(ctx context.Context) (<-chan *discovery.Event, []error) {
+	if len(dm.discoveries) == 0 {
+		return nil, []error{fmt.Errorf("no discovery available")}
+	}
+	var wg sync.WaitGroup
+	errs := []error{}
+	res := make(chan *discovery.Event)
+	for _, id := range dm.IDs() {
+		disc := dm.discoveries[id]
+		events, err := disc.Watch(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("starting discovery %s: %s", id, err))
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for event := range events {
+				select {
+				case res <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	if len(errs) == len(dm.discoveries) {
+		return nil, errs
+	}
+	go func() {
+		wg.Wait()
+		close(res)
+	}()
+	return res, errs
+}
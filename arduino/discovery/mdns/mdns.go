@@ -0,0 +1,289 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package mdns
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/discovery"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/oleksandr/bonjour"
+	"github.com/sirupsen/logrus"
+)
+
+// ServiceType is the mDNS service advertised by the boards supporting
+// network upload
+const ServiceType = "_arduino._tcp"
+
+const (
+	defaultBrowseTimeout = 2 * time.Second
+	defaultWatchInterval = 5 * time.Second
+	defaultIdleTimeout   = time.Minute
+)
+
+// Discovery detects the boards advertising themselves through mDNS. The
+// detected ports use the "network" protocol and have the address in the
+// form IP:PORT, the TXT records of the service are available in the port
+// preferences.
+type Discovery struct {
+	BrowseTimeout time.Duration
+	WatchInterval time.Duration
+	// IdleTimeout stops the background browsing when List is not called
+	// for this time
+	IdleTimeout time.Duration
+
+	mux      sync.Mutex
+	stop     chan struct{} // not nil while browsing in background
+	browsed  chan struct{} // closed when the first browse is completed
+	lastUsed time.Time
+	ports    []*discovery.Port
+	// browser browses the network, replaceable for testing
+	browser func() ([]*discovery.Port, error)
+}
+
+// New creates a new mDNS discovery
+func New() *Discovery {
+	d := &Discovery{
+		BrowseTimeout: defaultBrowseTimeout,
+		WatchInterval: defaultWatchInterval,
+		IdleTimeout:   defaultIdleTimeout,
+	}
+	d.browser = d.browse
+	return d
+}
+
+// GetID returns the identifier of the discovery
+func (d *Discovery) GetID() string {
+	return "mdns"
+}
+
+func (d *Discovery) String() string {
+	return d.GetID()
+}
+
+// Start starts browsing the network in the background, every
+// WatchInterval, to keep the list of ports returned by List up to date.
+// The browsing goes on until Stop is called or List is not called for
+// IdleTimeout. Calling Start while browsing has no effect.
+func (d *Discovery) Start() {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	d.lastUsed = time.Now()
+	if d.stop != nil {
+		return
+	}
+	d.stop = make(chan struct{})
+	d.browsed = make(chan struct{})
+	go d.browseLoop(d.stop, d.browsed)
+}
+
+// Stop stops the background browsing
+func (d *Discovery) Stop() {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if d.stop != nil {
+		close(d.stop)
+		d.stop = nil
+	}
+}
+
+func (d *Discovery) browseLoop(stop, browsed chan struct{}) {
+	for first := true; ; first = false {
+		ports, err := d.browser()
+		if err != nil {
+			logrus.WithError(err).Warn("Browsing mDNS services")
+		}
+
+		d.mux.Lock()
+		if err == nil {
+			d.ports = ports
+		}
+		idle := time.Since(d.lastUsed) > d.IdleTimeout
+		if idle && d.stop == stop {
+			d.stop = nil
+		}
+		d.mux.Unlock()
+
+		if first {
+			close(browsed)
+		}
+		if idle {
+			return
+		}
+		select {
+		case <-time.After(d.WatchInterval):
+		case <-stop:
+			return
+		}
+	}
+}
+
+// List returns the ports found by the last browse of the network. The
+// first call starts the background browsing and waits for the first
+// browse to complete, the following calls return immediately.
+func (d *Discovery) List() ([]*discovery.Port, error) {
+	d.Start()
+	d.mux.Lock()
+	browsed := d.browsed
+	d.mux.Unlock()
+	<-browsed
+
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	return append([]*discovery.Port{}, d.ports...), nil
+}
+
+// browse browses the network for the board services and returns the ports
+// found within the browse timeout
+func (d *Discovery) browse() ([]*discovery.Port, error) {
+	resolver, err := bonjour.NewResolver(nil)
+	if err != nil {
+		return nil, fmt.Errorf("initializing mDNS resolver: %s", err)
+	}
+
+	entries := make(chan *bonjour.ServiceEntry, 16)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	var lock sync.Mutex
+	ports := map[string]*discovery.Port{}
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case entry := <-entries:
+				if port := portFromEntry(entry); port != nil {
+					lock.Lock()
+					ports[port.Address] = port
+					lock.Unlock()
+				}
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	if err := resolver.Browse(ServiceType, "", entries); err != nil {
+		close(stop)
+		return nil, fmt.Errorf("browsing mDNS services: %s", err)
+	}
+	time.Sleep(d.BrowseTimeout)
+	// the entries are still consumed while the resolver is stopping
+	resolver.Exit <- true
+	close(stop)
+	<-done
+
+	lock.Lock()
+	defer lock.Unlock()
+	res := []*discovery.Port{}
+	for _, port := range ports {
+		res = append(res, port)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Address < res[j].Address })
+	return res, nil
+}
+
+// Watch browses the network periodically and sends an "add" event when a
+// new board is found and a "remove" event when a board is no longer
+// advertised
+func (d *Discovery) Watch(ctx context.Context) (<-chan *discovery.Event, error) {
+	events := make(chan *discovery.Event)
+	go func() {
+		defer close(events)
+		known := map[string]*discovery.Port{}
+		send := func(eventType string, port *discovery.Port) bool {
+			select {
+			case events <- &discovery.Event{Type: eventType, Port: port}:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		for {
+			ports, err := d.browse()
+			if err != nil {
+				logrus.WithError(err).Warn("Browsing mDNS services")
+			} else {
+				current := map[string]*discovery.Port{}
+				for _, port := range ports {
+					current[port.Address] = port
+					if _, ok := known[port.Address]; !ok && !send("add", port) {
+						return
+					}
+				}
+				for address, port := range known {
+					if _, ok := current[address]; !ok && !send("remove", port) {
+						return
+					}
+				}
+				known = current
+			}
+
+			select {
+			case <-time.After(d.WatchInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+// portFromEntry converts an mDNS service entry to a Port, returns nil if
+// the entry has no address
+func portFromEntry(entry *bonjour.ServiceEntry) *discovery.Port {
+	var ip net.IP
+	if entry.AddrIPv4 != nil {
+		ip = entry.AddrIPv4
+	} else if entry.AddrIPv6 != nil {
+		ip = entry.AddrIPv6
+	} else {
+		return nil
+	}
+	address := net.JoinHostPort(ip.String(), strconv.Itoa(entry.Port))
+
+	prefs := properties.NewMap()
+	prefs.Set("hostname", strings.TrimSuffix(entry.HostName, "."))
+	prefs.Set("port", strconv.Itoa(entry.Port))
+	idPrefs := properties.NewMap()
+	for _, txt := range entry.Text {
+		split := strings.SplitN(txt, "=", 2)
+		if len(split) != 2 {
+			continue
+		}
+		prefs.Set(split[0], split[1])
+		if split[0] == "board" {
+			idPrefs.Set("board", split[1])
+		}
+	}
+
+	return &discovery.Port{
+		Address:             address,
+		Label:               fmt.Sprintf("%s at %s", entry.Instance, ip),
+		Prefs:               prefs,
+		IdentificationPrefs: idPrefs,
+		Protocol:            "network",
+		ProtocolLabel:       "Network Port",
+	}
+}
@@ -0,0 +1,92 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package mdns
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/discovery"
+	"github.com/oleksandr/bonjour"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortFromEntry(t *testing.T) {
+	entry := bonjour.NewServiceEntry("esp32-ota", ServiceType, "local")
+	entry.HostName = "esp32-ota.local."
+	entry.Port = 3232
+	entry.Text = []string{"board=esp32", "auth_upload=yes", "tcp_check=no"}
+	require.Nil(t, portFromEntry(entry))
+
+	entry.AddrIPv4 = net.ParseIP("192.168.1.20")
+	port := portFromEntry(entry)
+	require.NotNil(t, port)
+	require.Equal(t, "192.168.1.20:3232", port.Address)
+	require.Equal(t, "network", port.Protocol)
+	require.Equal(t, "esp32-ota at 192.168.1.20", port.Label)
+	require.Equal(t, "esp32-ota.local", port.Prefs.Get("hostname"))
+	require.Equal(t, "yes", port.Prefs.Get("auth_upload"))
+	require.Equal(t, "esp32", port.IdentificationPrefs.Get("board"))
+	require.Equal(t, 1, port.IdentificationPrefs.Size())
+}
+
+func TestListBackgroundBrowse(t *testing.T) {
+	d := New()
+	browses := make(chan bool, 10)
+	d.browser = func() ([]*discovery.Port, error) {
+		browses <- true
+		return []*discovery.Port{{Address: "192.168.1.20:3232", Protocol: "network"}}, nil
+	}
+	defer d.Stop()
+
+	// the first call waits for the first browse
+	ports, err := d.List()
+	require.NoError(t, err)
+	require.Len(t, ports, 1)
+	require.Equal(t, "192.168.1.20:3232", ports[0].Address)
+
+	// the following calls use the cached ports
+	_, err = d.List()
+	require.NoError(t, err)
+	require.Len(t, browses, 1)
+}
+
+func TestBackgroundBrowseStops(t *testing.T) {
+	d := New()
+	d.WatchInterval = time.Millisecond
+	d.IdleTimeout = 0
+	d.browser = func() ([]*discovery.Port, error) { return nil, nil }
+
+	// the browsing stops when List is not called anymore
+	_, err := d.List()
+	require.NoError(t, err)
+	stopped := func() bool {
+		d.mux.Lock()
+		defer d.mux.Unlock()
+		return d.stop == nil
+	}
+	for deadline := time.Now().Add(time.Second); !stopped() && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+	}
+	require.True(t, stopped())
+
+	d.Start()
+	d.Stop()
+	require.Nil(t, d.stop)
+}
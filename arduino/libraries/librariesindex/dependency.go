@@ -0,0 +1,88 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package librariesindex
+
+import (
+	"fmt"
+	"strings"
+
+	semver "go.bug.st/relaxed-semver"
+)
+
+// VersionConstraint is a condition on the version of a library, as it
+// appears in the "dependencies" field of the library index (for example
+// ">=1.2.0"). A version without operator is an exact match.
+type VersionConstraint struct {
+	Operator string
+	Version  *semver.Version
+}
+
+// operators must be sorted so that longer operators are matched first
+var constraintOperators = []string{">=", "<=", "=", ">", "<"}
+
+// ParseVersionConstraint parses a version constraint. An empty string
+// means "any version" and returns a nil constraint.
+func ParseVersionConstraint(constraint string) (*VersionConstraint, error) {
+	constraint = strings.TrimSpace(constraint)
+	if constraint == "" {
+		return nil, nil
+	}
+	operator := "="
+	for _, op := range constraintOperators {
+		if strings.HasPrefix(constraint, op) {
+			operator = op
+			constraint = strings.TrimSpace(constraint[len(op):])
+			break
+		}
+	}
+	version, err := semver.Parse(constraint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version in constraint: %s", err)
+	}
+	return &VersionConstraint{Operator: operator, Version: version}, nil
+}
+
+// Match returns true if the version satisfies the constraint. A nil
+// constraint matches any version.
+func (c *VersionConstraint) Match(version *semver.Version) bool {
+	if c == nil {
+		return true
+	}
+	if version == nil {
+		return false
+	}
+	switch c.Operator {
+	case ">=":
+		return version.GreaterThanOrEqual(c.Version)
+	case "<=":
+		return version.LessThanOrEqual(c.Version)
+	case ">":
+		return version.GreaterThan(c.Version)
+	case "<":
+		return version.LessThan(c.Version)
+	default:
+		return version.Equal(c.Version)
+	}
+}
+
+func (c *VersionConstraint) String() string {
+	if c == nil {
+		return ""
+	}
+	return c.Operator + c.Version.String()
+}
@@ -53,10 +53,24 @@ type Release struct {
 	Architectures []string
 	Types         []string
 	Resource      *resources.DownloadResource
+	Dependencies  []*Dependency
 
 	Library *Library `json:"-"`
 }
 
+// Dependency is a library required by a Release
+type Dependency struct {
+	Name              string
+	VersionConstraint *VersionConstraint
+}
+
+func (d *Dependency) String() string {
+	if d.VersionConstraint == nil {
+		return d.Name
+	}
+	return d.Name + " (" + d.VersionConstraint.String() + ")"
+}
+
 func (r *Release) String() string {
 	return r.Library.Name + "@" + r.Version.String()
 }
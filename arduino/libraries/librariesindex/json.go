@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
 	semver "go.bug.st/relaxed-semver"
 
 	"github.com/arduino/arduino-cli/arduino/resources"
@@ -32,20 +33,26 @@ type indexJSON struct {
 }
 
 type indexRelease struct {
-	Name            string          `json:"name,required"`
-	Version         *semver.Version `json:"version,required"`
-	Author          string          `json:"author"`
-	Maintainer      string          `json:"maintainer"`
-	Sentence        string          `json:"sentence"`
-	Paragraph       string          `json:"paragraph"`
-	Website         string          `json:"website"`
-	Category        string          `json:"category"`
-	Architectures   []string        `json:"architectures"`
-	Types           []string        `json:"types"`
-	URL             string          `json:"url"`
-	ArchiveFileName string          `json:"archiveFileName"`
-	Size            int64           `json:"size"`
-	Checksum        string          `json:"checksum"`
+	Name            string             `json:"name,required"`
+	Version         *semver.Version    `json:"version,required"`
+	Author          string             `json:"author"`
+	Maintainer      string             `json:"maintainer"`
+	Sentence        string             `json:"sentence"`
+	Paragraph       string             `json:"paragraph"`
+	Website         string             `json:"website"`
+	Category        string             `json:"category"`
+	Architectures   []string           `json:"architectures"`
+	Types           []string           `json:"types"`
+	URL             string             `json:"url"`
+	ArchiveFileName string             `json:"archiveFileName"`
+	Size            int64              `json:"size"`
+	Checksum        string             `json:"checksum"`
+	Dependencies    []*indexDependency `json:"dependencies,omitempty"`
+}
+
+type indexDependency struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
 }
 
 // LoadIndex reads a library_index.json and create the corresponding Index
@@ -104,10 +111,30 @@ func (indexLib *indexRelease) extractReleaseIn(library *Library) {
 			Checksum:        indexLib.Checksum,
 			CachePath:       "libraries",
 		},
-		Library: library,
+		Library:      library,
+		Dependencies: indexLib.extractDependencies(),
 	}
 	library.Releases[indexLib.Version.String()] = release
 	if library.Latest == nil || library.Latest.Version.LessThan(release.Version) {
 		library.Latest = release
 	}
 }
+
+func (indexLib *indexRelease) extractDependencies() []*Dependency {
+	res := []*Dependency{}
+	for _, indexDep := range indexLib.Dependencies {
+		constraint, err := ParseVersionConstraint(indexDep.Version)
+		if err != nil {
+			logrus.
+				WithField("library", indexLib.Name+"@"+indexLib.Version.String()).
+				WithField("dependency", indexDep.Name).
+				Warnf("Ignoring invalid version constraint: %s", err)
+			constraint = nil
+		}
+		res = append(res, &Dependency{
+			Name:              indexDep.Name,
+			VersionConstraint: constraint,
+		})
+	}
+	return res
+}
@@ -0,0 +1,153 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package librariesindex
+
+import (
+	"fmt"
+	"sort"
+
+	semver "go.bug.st/relaxed-semver"
+)
+
+// ResolveDependencies computes the set of releases needed to install the
+// given release together with all its (transitive) dependencies. The
+// installed map contains the versions of the libraries already installed,
+// indexed by library name: an installed version is preferred over any
+// other as long as it satisfies all the constraints. Otherwise the most
+// recent release that satisfies the constraints is selected.
+// The returned list always starts with the given release and contains
+// only releases available in the index.
+func (idx *Index) ResolveDependencies(lib *Release, installed map[string]*semver.Version) ([]*Release, error) {
+	r := &resolver{
+		index:     idx,
+		installed: installed,
+		selected:  map[string]*semver.Version{},
+		releases:  map[string]*Release{},
+	}
+	r.selectRelease(lib.Library.Name, lib.Version, lib)
+	if !r.solve(lib.Dependencies) {
+		return nil, fmt.Errorf("cannot resolve dependencies of %s: %s", lib, r.err)
+	}
+
+	res := []*Release{}
+	for _, name := range r.order {
+		if release := r.releases[name]; release != nil {
+			res = append(res, release)
+		}
+	}
+	return res, nil
+}
+
+type resolver struct {
+	index     *Index
+	installed map[string]*semver.Version
+	selected  map[string]*semver.Version
+	releases  map[string]*Release
+	order     []string
+	err       error
+}
+
+type resolverCandidate struct {
+	version *semver.Version
+	release *Release
+}
+
+func (r *resolver) selectRelease(name string, version *semver.Version, release *Release) {
+	r.selected[name] = version
+	r.releases[name] = release
+	r.order = append(r.order, name)
+}
+
+func (r *resolver) unselectRelease(name string) {
+	delete(r.selected, name)
+	delete(r.releases, name)
+	r.order = r.order[:len(r.order)-1]
+}
+
+// solve tries to satisfy all the pending dependencies, backtracking to the
+// previous choice when a conflict is found.
+func (r *resolver) solve(pending []*Dependency) bool {
+	if len(pending) == 0 {
+		return true
+	}
+	dep := pending[0]
+	rest := pending[1:]
+
+	if version, selected := r.selected[dep.Name]; selected {
+		if !dep.VersionConstraint.Match(version) {
+			r.err = fmt.Errorf("%s is required but %s@%s is selected", dep, dep.Name, version)
+			return false
+		}
+		return r.solve(rest)
+	}
+
+	candidates := r.candidates(dep)
+	if len(candidates) == 0 {
+		r.err = fmt.Errorf("no release of %s matches the requirements", dep)
+		return false
+	}
+	for _, candidate := range candidates {
+		r.selectRelease(dep.Name, candidate.version, candidate.release)
+		next := append([]*Dependency{}, rest...)
+		if candidate.release != nil {
+			next = append(next, candidate.release.Dependencies...)
+		}
+		if r.solve(next) {
+			return true
+		}
+		r.unselectRelease(dep.Name)
+	}
+	return false
+}
+
+// candidates returns the versions that may satisfy the dependency, sorted
+// by preference: the installed version first, then the releases available
+// in the index from the newest to the oldest.
+func (r *resolver) candidates(dep *Dependency) []*resolverCandidate {
+	res := []*resolverCandidate{}
+	library := r.index.Libraries[dep.Name]
+
+	installed := r.installed[dep.Name]
+	if installed != nil && dep.VersionConstraint.Match(installed) {
+		candidate := &resolverCandidate{version: installed}
+		if library != nil {
+			candidate.release = library.Releases[installed.String()]
+		}
+		res = append(res, candidate)
+	}
+
+	if library == nil {
+		return res
+	}
+	releases := []*Release{}
+	for _, release := range library.Releases {
+		if installed != nil && release.Version.Equal(installed) {
+			continue
+		}
+		if dep.VersionConstraint.Match(release.Version) {
+			releases = append(releases, release)
+		}
+	}
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].Version.GreaterThan(releases[j].Version)
+	})
+	for _, release := range releases {
+		res = append(res, &resolverCandidate{version: release.Version, release: release})
+	}
+	return res
+}
@@ -0,0 +1,113 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package librariesindex
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+var testIndexJSON = `{ "libraries": [
+	{ "name": "Sensor Driver", "version": "1.0.0", "dependencies": [ { "name": "Unified Sensor" } ] },
+	{ "name": "Sensor Driver", "version": "2.0.0", "dependencies": [ { "name": "Unified Sensor", "version": ">=1.1.0" }, { "name": "Bus IO", "version": ">=1.0.0" } ] },
+	{ "name": "Unified Sensor", "version": "1.0.0" },
+	{ "name": "Unified Sensor", "version": "1.1.0" },
+	{ "name": "Unified Sensor", "version": "1.2.0", "dependencies": [ { "name": "Bus IO", "version": "<1.0.0" } ] },
+	{ "name": "Bus IO", "version": "0.9.0" },
+	{ "name": "Bus IO", "version": "1.0.0" },
+	{ "name": "Broken", "version": "1.0.0", "dependencies": [ { "name": "Missing" } ] },
+	{ "name": "Cyclic A", "version": "1.0.0", "dependencies": [ { "name": "Cyclic B" } ] },
+	{ "name": "Cyclic B", "version": "1.0.0", "dependencies": [ { "name": "Cyclic A" } ] }
+]}`
+
+func loadTestIndex(t *testing.T) *Index {
+	var i indexJSON
+	require.NoError(t, json.Unmarshal([]byte(testIndexJSON), &i))
+	index, err := i.extractIndex()
+	require.NoError(t, err)
+	return index
+}
+
+func TestVersionConstraint(t *testing.T) {
+	c, err := ParseVersionConstraint("")
+	require.NoError(t, err)
+	require.Nil(t, c)
+	require.True(t, c.Match(semver.MustParse("1.0.0")))
+
+	c, err = ParseVersionConstraint(">= 1.2.0")
+	require.NoError(t, err)
+	require.Equal(t, ">=1.2.0", c.String())
+	require.True(t, c.Match(semver.MustParse("1.2.0")))
+	require.True(t, c.Match(semver.MustParse("1.3.0")))
+	require.False(t, c.Match(semver.MustParse("1.1.9")))
+
+	c, err = ParseVersionConstraint("1.2.0")
+	require.NoError(t, err)
+	require.Equal(t, "=1.2.0", c.String())
+	require.False(t, c.Match(semver.MustParse("1.3.0")))
+
+	_, err = ParseVersionConstraint(">=a.b.c")
+	require.Error(t, err)
+}
+
+func TestResolveDependencies(t *testing.T) {
+	index := loadTestIndex(t)
+	resolve := func(ref string, installed map[string]*semver.Version) ([]string, error) {
+		name, version := ref, (*semver.Version)(nil)
+		if ref == "Sensor Driver@1.0.0" {
+			name, version = "Sensor Driver", semver.MustParse("1.0.0")
+		}
+		release := index.FindRelease(&Reference{Name: name, Version: version})
+		require.NotNil(t, release)
+		releases, err := index.ResolveDependencies(release, installed)
+		if err != nil {
+			return nil, err
+		}
+		res := []string{}
+		for _, r := range releases {
+			res = append(res, r.String())
+		}
+		return res, nil
+	}
+
+	// Unified Sensor@1.2.0 conflicts with Bus IO@1.0.0, the resolver
+	// must backtrack and pick the older Unified Sensor@1.1.0
+	res, err := resolve("Sensor Driver", nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Sensor Driver@2.0.0", "Unified Sensor@1.1.0", "Bus IO@1.0.0"}, res)
+
+	// Already installed libraries are kept if they satisfy the constraints
+	res, err = resolve("Sensor Driver@1.0.0", map[string]*semver.Version{"Unified Sensor": semver.MustParse("1.0.0")})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Sensor Driver@1.0.0", "Unified Sensor@1.0.0"}, res)
+
+	// ...and replaced otherwise
+	res, err = resolve("Sensor Driver", map[string]*semver.Version{"Unified Sensor": semver.MustParse("1.0.0")})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Sensor Driver@2.0.0", "Unified Sensor@1.1.0", "Bus IO@1.0.0"}, res)
+
+	res, err = resolve("Cyclic A", nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Cyclic A@1.0.0", "Cyclic B@1.0.0"}, res)
+
+	_, err = resolve("Broken", nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,239 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to modify or
+// otherwise use the software for commercial activities involving the Arduino
+// software without disclosing the source code of your own applications. To purchase
+// a commercial license, send an email to license@arduino.cc.
+
+package monitors
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func init() {
+	Register("replay", func(target string, config map[string]interface{}) (Monitor, error) {
+		speed, err := configFloat(config, "Speed")
+		if err != nil {
+			return nil, err
+		}
+		mon, err := OpenReplayMonitor(target, speed)
+		if err != nil {
+			return nil, err
+		}
+		return mon, nil
+	},
+		&PortSetting{ID: "Speed", Label: "Replay speed", Type: "float", Default: "1"},
+	)
+}
+
+// CaptureEntry is a chunk of data exchanged with a monitor target, as
+// stored in a session capture. A capture file contains one JSON encoded
+// entry per line.
+type CaptureEntry struct {
+	Time time.Time `json:"time"`
+	// Direction is "rx" for the data received from the target and "tx" for
+	// the data sent to the target
+	Direction string `json:"dir"`
+	Data      []byte `json:"data"`
+}
+
+// RecordingMonitor is a Monitor that saves all the data exchanged with the
+// wrapped monitor to a capture
+type RecordingMonitor struct {
+	Monitor
+	mux     sync.Mutex
+	capture io.Writer
+	encoder *json.Encoder
+}
+
+// NewRecordingMonitor wraps the given monitor and records the session on
+// the capture writer. If the writer is an io.Closer it is closed together
+// with the monitor.
+func NewRecordingMonitor(mon Monitor, capture io.Writer) *RecordingMonitor {
+	return &RecordingMonitor{
+		Monitor: mon,
+		capture: capture,
+		encoder: json.NewEncoder(capture),
+	}
+}
+
+func (mon *RecordingMonitor) record(direction string, data []byte) error {
+	mon.mux.Lock()
+	defer mon.mux.Unlock()
+	err := mon.encoder.Encode(&CaptureEntry{
+		Time:      time.Now(),
+		Direction: direction,
+		Data:      data,
+	})
+	if err != nil {
+		return errors.Wrap(err, "error recording monitor session")
+	}
+	return nil
+}
+
+// Read bytes from the monitor and record them
+func (mon *RecordingMonitor) Read(bytes []byte) (int, error) {
+	n, err := mon.Monitor.Read(bytes)
+	if n > 0 {
+		if err := mon.record("rx", bytes[:n]); err != nil {
+			return n, err
+		}
+	}
+	return n, err
+}
+
+// Write bytes to the monitor and record them
+func (mon *RecordingMonitor) Write(bytes []byte) (int, error) {
+	n, err := mon.Monitor.Write(bytes)
+	if n > 0 {
+		if err := mon.record("tx", bytes[:n]); err != nil {
+			return n, err
+		}
+	}
+	return n, err
+}
+
+// Configure forwards the new settings to the wrapped monitor
+func (mon *RecordingMonitor) Configure(config map[string]interface{}) error {
+	configurable, ok := mon.Monitor.(Configurable)
+	if !ok {
+		return fmt.Errorf("the monitor target doesn't support changing settings")
+	}
+	return configurable.Configure(config)
+}
+
+// Close the wrapped monitor and the capture
+func (mon *RecordingMonitor) Close() error {
+	err := mon.Monitor.Close()
+	if closer, ok := mon.capture.(io.Closer); ok {
+		mon.mux.Lock()
+		defer mon.mux.Unlock()
+		if cerr := closer.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// ReplayMonitor is a Monitor that plays back the data received in a
+// recorded session, respecting the original timing. The data written to
+// the monitor is discarded.
+type ReplayMonitor struct {
+	file    *os.File
+	scanner *bufio.Scanner
+	speed   float64
+	start   time.Time
+	origin  time.Time
+	pending []byte
+	closing chan struct{}
+	closed  sync.Once
+
+	// the clock of the replay, replaceable for testing
+	now   func() time.Time
+	after func(time.Duration) <-chan time.Time
+}
+
+// OpenReplayMonitor creates a monitor that replays the capture file at the
+// given path. The speed is a multiplier of the original timing: 1 replays
+// the session in real time, 10 ten times faster. If speed is 0 the session
+// is replayed in real time.
+func OpenReplayMonitor(path string, speed float64) (*ReplayMonitor, error) {
+	if speed < 0 {
+		return nil, fmt.Errorf("invalid replay speed: %v", speed)
+	}
+	if speed == 0 {
+		speed = 1
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "error opening capture")
+	}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 16*1024*1024)
+	return &ReplayMonitor{
+		file:    file,
+		scanner: scanner,
+		speed:   speed,
+		closing: make(chan struct{}),
+		now:     time.Now,
+		after:   time.After,
+	}, nil
+}
+
+// next returns the next chunk of received data from the capture
+func (mon *ReplayMonitor) next() (*CaptureEntry, error) {
+	for mon.scanner.Scan() {
+		entry := &CaptureEntry{}
+		if err := json.Unmarshal(mon.scanner.Bytes(), entry); err != nil {
+			return nil, errors.Wrap(err, "error reading capture")
+		}
+		if entry.Direction == "rx" && len(entry.Data) > 0 {
+			return entry, nil
+		}
+	}
+	if err := mon.scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "error reading capture")
+	}
+	return nil, io.EOF
+}
+
+// Read the next chunk of data from the capture, waiting for the time
+// elapsed in the original session. Returns io.EOF at the end of the
+// capture or when the monitor is closed.
+func (mon *ReplayMonitor) Read(bytes []byte) (int, error) {
+	if len(mon.pending) == 0 {
+		entry, err := mon.next()
+		if err != nil {
+			return 0, err
+		}
+		if mon.start.IsZero() {
+			mon.start = mon.now()
+			mon.origin = entry.Time
+		}
+		delay := time.Duration(float64(entry.Time.Sub(mon.origin)) / mon.speed)
+		select {
+		case <-mon.after(mon.start.Add(delay).Sub(mon.now())):
+		case <-mon.closing:
+			return 0, io.EOF
+		}
+		mon.pending = entry.Data
+	}
+
+	n := copy(bytes, mon.pending)
+	mon.pending = mon.pending[n:]
+	return n, nil
+}
+
+// Write discards the data, the target of a replayed session can't receive
+// anything
+func (mon *ReplayMonitor) Write(bytes []byte) (int, error) {
+	return len(bytes), nil
+}
+
+// Close the capture file
+func (mon *ReplayMonitor) Close() error {
+	err := error(nil)
+	mon.closed.Do(func() {
+		close(mon.closing)
+		err = mon.file.Close()
+	})
+	return err
+}
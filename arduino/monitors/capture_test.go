@@ -0,0 +1,109 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to modify or
+// otherwise use the software for commercial activities involving the Arduino
+// software without disclosing the source code of your own applications. To purchase
+// a commercial license, send an email to license@arduino.cc.
+
+package monitors
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "monitor-capture")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmp)
+	captureFile := filepath.Join(tmp, "session.log")
+
+	capture, err := os.Create(captureFile)
+	require.NoError(t, err)
+	mon := NewRecordingMonitor(OpenLoopbackMonitor(), capture)
+	buf := make([]byte, 16)
+	for _, msg := range []string{"Hello", "World"} {
+		_, err = mon.Write([]byte(msg))
+		require.NoError(t, err)
+		n, err := mon.Read(buf)
+		require.NoError(t, err)
+		require.Equal(t, msg, string(buf[:n]))
+	}
+	require.NoError(t, mon.Close())
+
+	data, err := ioutil.ReadFile(captureFile)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 4)
+	directions := []string{}
+	for _, line := range lines {
+		entry := &CaptureEntry{}
+		require.NoError(t, json.Unmarshal([]byte(line), entry))
+		directions = append(directions, entry.Direction)
+	}
+	require.Equal(t, []string{"tx", "rx", "tx", "rx"}, directions)
+
+	replay, err := Open("replay", captureFile, map[string]interface{}{"Speed": 1000})
+	require.NoError(t, err)
+	out := &bytes.Buffer{}
+	_, err = out.ReadFrom(replay)
+	require.NoError(t, err)
+	require.Equal(t, "HelloWorld", out.String())
+	require.NoError(t, replay.Close())
+}
+
+func TestReplaySpeed(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "monitor-capture")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmp)
+	captureFile := filepath.Join(tmp, "session.log")
+
+	start := time.Now()
+	capture := &bytes.Buffer{}
+	encoder := json.NewEncoder(capture)
+	encoder.Encode(&CaptureEntry{Time: start, Direction: "rx", Data: []byte("A")})
+	encoder.Encode(&CaptureEntry{Time: start.Add(time.Second), Direction: "rx", Data: []byte("B")})
+	require.NoError(t, ioutil.WriteFile(captureFile, capture.Bytes(), 0644))
+
+	replay, err := OpenReplayMonitor(captureFile, 10)
+	require.NoError(t, err)
+	defer replay.Close()
+
+	// the clock is stopped: the delays requested are the ones in the
+	// capture, scaled by the replay speed
+	delays := []time.Duration{}
+	replay.now = func() time.Time { return start }
+	replay.after = func(d time.Duration) <-chan time.Time {
+		delays = append(delays, d)
+		fired := make(chan time.Time, 1)
+		fired <- start.Add(d)
+		return fired
+	}
+	buf := make([]byte, 16)
+	n, err := replay.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, "A", string(buf[:n]))
+	n, err = replay.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, "B", string(buf[:n]))
+	require.Equal(t, []time.Duration{0, 100 * time.Millisecond}, delays)
+
+	_, err = OpenReplayMonitor(captureFile, -1)
+	require.Error(t, err)
+}
@@ -0,0 +1,81 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to modify or
+// otherwise use the software for commercial activities involving the Arduino
+// software without disclosing the source code of your own applications. To purchase
+// a commercial license, send an email to license@arduino.cc.
+
+package monitors
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+func init() {
+	Register("loopback", func(target string, config map[string]interface{}) (Monitor, error) {
+		return OpenLoopbackMonitor(), nil
+	})
+}
+
+// LoopbackMonitor is a monitor that echoes back all the data written to
+// it. It doesn't need any hardware and is useful for testing.
+type LoopbackMonitor struct {
+	mux    sync.Mutex
+	cond   *sync.Cond
+	buffer bytes.Buffer
+	closed bool
+}
+
+// OpenLoopbackMonitor creates a new loopback monitor instance
+func OpenLoopbackMonitor() *LoopbackMonitor {
+	mon := &LoopbackMonitor{}
+	mon.cond = sync.NewCond(&mon.mux)
+	return mon
+}
+
+// Close the monitor, pending reads will return io.EOF once the buffered
+// data has been consumed
+func (mon *LoopbackMonitor) Close() error {
+	mon.mux.Lock()
+	defer mon.mux.Unlock()
+	mon.closed = true
+	mon.cond.Broadcast()
+	return nil
+}
+
+// Read the bytes previously written, blocks until some data is available
+func (mon *LoopbackMonitor) Read(bytes []byte) (int, error) {
+	mon.mux.Lock()
+	defer mon.mux.Unlock()
+	for mon.buffer.Len() == 0 && !mon.closed {
+		mon.cond.Wait()
+	}
+	if mon.buffer.Len() == 0 {
+		return 0, io.EOF
+	}
+	return mon.buffer.Read(bytes)
+}
+
+// Write bytes to the monitor, they will be returned by the next reads
+func (mon *LoopbackMonitor) Write(bytes []byte) (int, error) {
+	mon.mux.Lock()
+	defer mon.mux.Unlock()
+	if mon.closed {
+		return 0, errors.New("loopback monitor is closed")
+	}
+	n, err := mon.buffer.Write(bytes)
+	mon.cond.Broadcast()
+	return n, err
+}
@@ -0,0 +1,123 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to modify or
+// otherwise use the software for commercial activities involving the Arduino
+// software without disclosing the source code of your own applications. To purchase
+// a commercial license, send an email to license@arduino.cc.
+
+package monitors
+
+import (
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	serial "go.bug.st/serial.v1"
+)
+
+func TestRegistry(t *testing.T) {
+	require.Subset(t, Types(), []string{"loopback", "serial", "tcp"})
+
+	_, err := Open("carrier-pigeon", "", nil)
+	require.EqualError(t, err, "unsupported monitor type: carrier-pigeon")
+
+	_, err = Open("serial", "/dev/ttyACM0", map[string]interface{}{"BaudRate": "fast"})
+	require.Error(t, err)
+}
+
+func TestLoopbackMonitor(t *testing.T) {
+	mon, err := Open("loopback", "", nil)
+	require.NoError(t, err)
+
+	_, err = mon.Write([]byte("Hello"))
+	require.NoError(t, err)
+	buf := make([]byte, 16)
+	n, err := mon.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, "Hello", string(buf[:n]))
+
+	require.NoError(t, mon.Close())
+	_, err = mon.Read(buf)
+	require.Equal(t, io.EOF, err)
+	_, err = mon.Write([]byte("Bye"))
+	require.Error(t, err)
+}
+
+func TestTCPMonitor(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		io.Copy(conn, conn)
+	}()
+
+	mon, err := Open("tcp", listener.Addr().String(), nil)
+	require.NoError(t, err)
+	defer mon.Close()
+
+	_, err = mon.Write([]byte("Hello"))
+	require.NoError(t, err)
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(mon, buf)
+	require.NoError(t, err)
+	require.Equal(t, "Hello", string(buf))
+}
+
+func TestParseSerialConfig(t *testing.T) {
+	base := serial.Mode{BaudRate: 9600, DataBits: 8}
+
+	config, err := parseSerialConfig(map[string]interface{}{}, base)
+	require.NoError(t, err)
+	require.Equal(t, base, config.Mode)
+	require.Nil(t, config.DTR)
+	require.Nil(t, config.RTS)
+
+	config, err = parseSerialConfig(map[string]interface{}{
+		"BaudRate": float64(115200),
+		"databits": "7",
+		"Parity":   "even",
+		"StopBits": float64(1),
+		"DTR":      false,
+		"rts":      "true",
+	}, base)
+	require.NoError(t, err)
+	require.Equal(t, serial.Mode{BaudRate: 115200, DataBits: 7, Parity: serial.EvenParity, StopBits: serial.OneStopBit}, config.Mode)
+	require.False(t, *config.DTR)
+	require.True(t, *config.RTS)
+
+	_, err = parseSerialConfig(map[string]interface{}{"DataBits": 9}, base)
+	require.Error(t, err)
+	_, err = parseSerialConfig(map[string]interface{}{"Parity": "weird"}, base)
+	require.Error(t, err)
+	_, err = parseSerialConfig(map[string]interface{}{"StopBits": 3}, base)
+	require.Error(t, err)
+	_, err = parseSerialConfig(map[string]interface{}{"DTR": 1}, base)
+	require.Error(t, err)
+}
+
+func TestSettings(t *testing.T) {
+	settings, err := Settings("serial")
+	require.NoError(t, err)
+	ids := []string{}
+	for _, setting := range settings {
+		ids = append(ids, setting.ID)
+	}
+	require.Equal(t, []string{"BaudRate", "DataBits", "Parity", "StopBits", "DTR", "RTS"}, ids)
+
+	_, err = Settings("carrier-pigeon")
+	require.Error(t, err)
+}
@@ -0,0 +1,171 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to modify or
+// otherwise use the software for commercial activities involving the Arduino
+// software without disclosing the source code of your own applications. To purchase
+// a commercial license, send an email to license@arduino.cc.
+
+package monitors
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Opener is a function that opens a Monitor on the given target. The
+// config map contains additional parameters specific to the type of
+// monitor, for example the baud rate of a serial port.
+type Opener func(target string, config map[string]interface{}) (Monitor, error)
+
+// PortSetting describes a setting accepted in the configuration of a
+// monitor, so that clients can show the available options to the user
+type PortSetting struct {
+	ID      string
+	Label   string
+	Type    string   // "enum", "bool", "int", "float" or "string"
+	Values  []string // the allowed values for "enum" settings
+	Default string
+}
+
+type registryEntry struct {
+	opener   Opener
+	settings []*PortSetting
+}
+
+var (
+	registryMutex sync.Mutex
+	registry      = map[string]*registryEntry{}
+)
+
+// Register makes a Monitor implementation available for the given target
+// type, together with the description of the settings it supports.
+// Registering the same type twice replaces the previous Opener.
+func Register(targetType string, opener Opener, settings ...*PortSetting) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	registry[targetType] = &registryEntry{opener: opener, settings: settings}
+}
+
+// Open opens a Monitor of the given target type using the Opener
+// registered for that type
+func Open(targetType string, target string, config map[string]interface{}) (Monitor, error) {
+	registryMutex.Lock()
+	entry, ok := registry[targetType]
+	registryMutex.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unsupported monitor type: %s", targetType)
+	}
+	return entry.opener(target, config)
+}
+
+// Settings returns the description of the settings supported by the
+// monitors of the given target type
+func Settings(targetType string) ([]*PortSetting, error) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	entry, ok := registry[targetType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported monitor type: %s", targetType)
+	}
+	return entry.settings, nil
+}
+
+// Types returns the sorted list of the registered target types
+func Types() []string {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	res := []string{}
+	for targetType := range registry {
+		res = append(res, targetType)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// configValue returns the value of a parameter from a monitor
+// configuration, the key is matched case insensitively
+func configValue(config map[string]interface{}, key string) interface{} {
+	if v, ok := config[key]; ok {
+		return v
+	}
+	for k, v := range config {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return nil
+}
+
+// configInt returns the value of an integer parameter from a monitor
+// configuration, or 0 if the parameter is missing
+func configInt(config map[string]interface{}, key string) (int, error) {
+	switch v := configValue(config, key).(type) {
+	case nil:
+		return 0, nil
+	case int:
+		return v, nil
+	case float64:
+		return int(v), nil
+	case string:
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, fmt.Errorf("invalid value for %s: %s", key, v)
+		}
+		return n, nil
+	default:
+		return 0, fmt.Errorf("invalid value for %s: %v", key, v)
+	}
+}
+
+// configFloat returns the value of a numeric parameter from a monitor
+// configuration, or 0 if the parameter is missing
+func configFloat(config map[string]interface{}, key string) (float64, error) {
+	switch v := configValue(config, key).(type) {
+	case nil:
+		return 0, nil
+	case int:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case string:
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid value for %s: %s", key, v)
+		}
+		return n, nil
+	default:
+		return 0, fmt.Errorf("invalid value for %s: %v", key, v)
+	}
+}
+
+// configBool returns the value of a boolean parameter from a monitor
+// configuration, or nil if the parameter is missing
+func configBool(config map[string]interface{}, key string) (*bool, error) {
+	var res bool
+	switch v := configValue(config, key).(type) {
+	case nil:
+		return nil, nil
+	case bool:
+		res = v
+	case string:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s: %s", key, v)
+		}
+		res = b
+	default:
+		return nil, fmt.Errorf("invalid value for %s: %v", key, v)
+	}
+	return &res, nil
+}
@@ -16,6 +16,10 @@
 package monitors
 
 import (
+	"fmt"
+	"strings"
+	"sync"
+
 	"github.com/pkg/errors"
 	serial "go.bug.st/serial.v1"
 )
@@ -24,9 +28,105 @@ const (
 	defaultBaudRate = 9600
 )
 
+var (
+	serialParities = map[string]serial.Parity{
+		"none":  serial.NoParity,
+		"odd":   serial.OddParity,
+		"even":  serial.EvenParity,
+		"mark":  serial.MarkParity,
+		"space": serial.SpaceParity,
+	}
+	serialStopBits = map[string]serial.StopBits{
+		"1":   serial.OneStopBit,
+		"1.5": serial.OnePointFiveStopBits,
+		"2":   serial.TwoStopBits,
+	}
+)
+
+func init() {
+	Register("serial", func(target string, config map[string]interface{}) (Monitor, error) {
+		serialConfig, err := parseSerialConfig(config, serial.Mode{BaudRate: defaultBaudRate, DataBits: 8})
+		if err != nil {
+			return nil, err
+		}
+		mon, err := OpenSerialMonitorWithConfig(target, serialConfig)
+		if err != nil {
+			return nil, err
+		}
+		return mon, nil
+	},
+		&PortSetting{ID: "BaudRate", Label: "Baud rate", Type: "enum", Default: "9600",
+			Values: []string{"300", "1200", "2400", "4800", "9600", "19200", "38400", "57600", "74880", "115200", "230400", "250000", "500000", "1000000", "2000000"}},
+		&PortSetting{ID: "DataBits", Label: "Data bits", Type: "enum", Default: "8", Values: []string{"5", "6", "7", "8"}},
+		&PortSetting{ID: "Parity", Label: "Parity", Type: "enum", Default: "none", Values: []string{"none", "even", "odd", "mark", "space"}},
+		&PortSetting{ID: "StopBits", Label: "Stop bits", Type: "enum", Default: "1", Values: []string{"1", "1.5", "2"}},
+		&PortSetting{ID: "DTR", Label: "DTR", Type: "bool", Default: "true"},
+		&PortSetting{ID: "RTS", Label: "RTS", Type: "bool", Default: "true"},
+	)
+}
+
+// SerialConfig contains the settings of a serial port
+type SerialConfig struct {
+	serial.Mode
+
+	// DTR and RTS are the states of the modem control lines, a nil value
+	// leaves the line untouched
+	DTR *bool
+	RTS *bool
+}
+
+// parseSerialConfig reads the serial port settings from a monitor
+// configuration, the settings missing from the configuration are taken
+// from the given mode
+func parseSerialConfig(config map[string]interface{}, mode serial.Mode) (*SerialConfig, error) {
+	res := &SerialConfig{Mode: mode}
+
+	if baudRate, err := configInt(config, "BaudRate"); err != nil {
+		return nil, err
+	} else if baudRate != 0 {
+		res.BaudRate = baudRate
+	}
+
+	if dataBits, err := configInt(config, "DataBits"); err != nil {
+		return nil, err
+	} else if dataBits != 0 {
+		if dataBits < 5 || dataBits > 8 {
+			return nil, fmt.Errorf("invalid value for DataBits: %d", dataBits)
+		}
+		res.DataBits = dataBits
+	}
+
+	if v := configValue(config, "Parity"); v != nil {
+		parity, ok := serialParities[strings.ToLower(fmt.Sprint(v))]
+		if !ok {
+			return nil, fmt.Errorf("invalid value for Parity: %v", v)
+		}
+		res.Parity = parity
+	}
+
+	if v := configValue(config, "StopBits"); v != nil {
+		stopBits, ok := serialStopBits[fmt.Sprint(v)]
+		if !ok {
+			return nil, fmt.Errorf("invalid value for StopBits: %v", v)
+		}
+		res.StopBits = stopBits
+	}
+
+	var err error
+	if res.DTR, err = configBool(config, "DTR"); err != nil {
+		return nil, err
+	}
+	if res.RTS, err = configBool(config, "RTS"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // SerialMonitor is a monitor for serial ports
 type SerialMonitor struct {
 	port serial.Port
+	mux  sync.Mutex
+	mode serial.Mode
 }
 
 // OpenSerialMonitor creates a monitor instance for a serial port
@@ -36,14 +136,59 @@ func OpenSerialMonitor(portName string, baudRate int) (*SerialMonitor, error) {
 		baudRate = defaultBaudRate
 	}
 
-	port, err := serial.Open(portName, &serial.Mode{BaudRate: baudRate})
+	return OpenSerialMonitorWithConfig(portName, &SerialConfig{Mode: serial.Mode{BaudRate: baudRate}})
+}
+
+// OpenSerialMonitorWithConfig creates a monitor instance for a serial port
+// using the given settings
+func OpenSerialMonitorWithConfig(portName string, config *SerialConfig) (*SerialMonitor, error) {
+	port, err := serial.Open(portName, &config.Mode)
 	if err != nil {
 		return nil, errors.Wrap(err, "error opening serial monitor")
 	}
 
-	return &SerialMonitor{
+	mon := &SerialMonitor{
 		port: port,
-	}, nil
+		mode: config.Mode,
+	}
+	if err := mon.setModemLines(config); err != nil {
+		port.Close()
+		return nil, err
+	}
+	return mon, nil
+}
+
+// Configure changes the settings of the open port, the settings missing
+// from the configuration are left unchanged
+func (mon *SerialMonitor) Configure(config map[string]interface{}) error {
+	mon.mux.Lock()
+	defer mon.mux.Unlock()
+
+	serialConfig, err := parseSerialConfig(config, mon.mode)
+	if err != nil {
+		return err
+	}
+	if serialConfig.Mode != mon.mode {
+		if err := mon.port.SetMode(&serialConfig.Mode); err != nil {
+			return errors.Wrap(err, "error changing serial port settings")
+		}
+		mon.mode = serialConfig.Mode
+	}
+	return mon.setModemLines(serialConfig)
+}
+
+func (mon *SerialMonitor) setModemLines(config *SerialConfig) error {
+	if config.DTR != nil {
+		if err := mon.port.SetDTR(*config.DTR); err != nil {
+			return errors.Wrap(err, "error setting DTR")
+		}
+	}
+	if config.RTS != nil {
+		if err := mon.port.SetRTS(*config.RTS); err != nil {
+			return errors.Wrap(err, "error setting RTS")
+		}
+	}
+	return nil
 }
 
 // Close the connection
@@ -0,0 +1,81 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to modify or
+// otherwise use the software for commercial activities involving the Arduino
+// software without disclosing the source code of your own applications. To purchase
+// a commercial license, send an email to license@arduino.cc.
+
+package monitors
+
+import (
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultTCPDialTimeout = 5 * time.Second
+)
+
+func init() {
+	Register("tcp", func(target string, config map[string]interface{}) (Monitor, error) {
+		timeout, err := configInt(config, "Timeout")
+		if err != nil {
+			return nil, err
+		}
+		mon, err := OpenTCPMonitor(target, time.Duration(timeout)*time.Millisecond)
+		if err != nil {
+			return nil, err
+		}
+		return mon, nil
+	},
+		&PortSetting{ID: "Timeout", Label: "Connection timeout (ms)", Type: "int", Default: "5000"},
+	)
+}
+
+// TCPMonitor is a monitor for TCP sockets, for example the telnet bridges
+// exposed by network capable boards
+type TCPMonitor struct {
+	conn net.Conn
+}
+
+// OpenTCPMonitor creates a monitor instance connected to the given
+// HOST:PORT address. If timeout is 0 a default dial timeout is used.
+func OpenTCPMonitor(address string, timeout time.Duration) (*TCPMonitor, error) {
+	if timeout == 0 {
+		timeout = defaultTCPDialTimeout
+	}
+
+	conn, err := net.DialTimeout("tcp", address, timeout)
+	if err != nil {
+		return nil, errors.Wrap(err, "error opening tcp monitor")
+	}
+
+	return &TCPMonitor{
+		conn: conn,
+	}, nil
+}
+
+// Close the connection
+func (mon *TCPMonitor) Close() error {
+	return mon.conn.Close()
+}
+
+// Read bytes from the socket
+func (mon *TCPMonitor) Read(bytes []byte) (int, error) {
+	return mon.conn.Read(bytes)
+}
+
+// Write bytes to the socket
+func (mon *TCPMonitor) Write(bytes []byte) (int, error) {
+	return mon.conn.Write(bytes)
+}
@@ -23,3 +23,9 @@ import (
 type Monitor interface {
 	io.ReadWriteCloser
 }
+
+// Configurable is implemented by the monitors whose settings can be
+// changed while the target is open
+type Configurable interface {
+	Configure(config map[string]interface{}) error
+}
@@ -38,7 +38,9 @@ type Sketch struct {
 
 // Metadata is the kind of data associated to a project such as the connected board
 type Metadata struct {
-	CPU BoardMetadata `json:"cpu,omitempty" gorethink:"cpu"`
+	CPU            BoardMetadata            `json:"cpu,omitempty" gorethink:"cpu"`
+	DefaultProfile string                   `json:"default_profile,omitempty"`
+	Profiles       map[string]*BuildProfile `json:"profiles,omitempty"`
 }
 
 // BoardMetadata represents the board metadata for the sketch
@@ -47,6 +49,74 @@ type BoardMetadata struct {
 	Name string `json:"name,omitempty"`
 }
 
+// BuildProfile is a named build configuration of the sketch
+type BuildProfile struct {
+	Fqbn            string            `json:"fqbn"` // including the menu options, e.g.: arduino:avr:nano:cpu=atmega328old
+	BuildProperties []string          `json:"build_properties,omitempty"`
+	Warnings        string            `json:"warnings,omitempty"`
+	Platforms       map[string]string `json:"platforms,omitempty"` // required platform versions, e.g.: "arduino:avr": "1.8.2"
+	Libraries       map[string]string `json:"libraries,omitempty"` // required library versions, e.g.: "Servo": "1.1.6"
+}
+
+// DefaultProfileName is the name of the default profile when the metadata
+// doesn't specify one
+const DefaultProfileName = "default"
+
+// DefaultProfileName returns the name of the profile used when none is
+// explicitly selected
+func (m *Metadata) DefaultProfileName() string {
+	if m == nil || m.DefaultProfile == "" {
+		return DefaultProfileName
+	}
+	return m.DefaultProfile
+}
+
+// Profile returns the build profile with the given name. If the name is
+// empty the default profile is returned, or nil if the sketch has no
+// default profile.
+func (m *Metadata) Profile(name string) (*BuildProfile, error) {
+	if name == "" {
+		if m == nil {
+			return nil, nil
+		}
+		return m.Profiles[m.DefaultProfileName()], nil
+	}
+	if m == nil || m.Profiles[name] == nil {
+		return nil, fmt.Errorf("build profile %s not found", name)
+	}
+	return m.Profiles[name], nil
+}
+
+// Fqbn returns the FQBN of the given build profile, falling back to the
+// attached board if the profile is not specified
+func (m *Metadata) Fqbn(profileName string) (string, error) {
+	profile, err := m.Profile(profileName)
+	if err != nil {
+		return "", err
+	}
+	if profile != nil && profile.Fqbn != "" {
+		return profile.Fqbn, nil
+	}
+	if m == nil {
+		return "", nil
+	}
+	return m.CPU.Fqbn, nil
+}
+
+// AttachBoard sets the board of the default profile, leaving the other
+// settings of the profile untouched
+func (m *Metadata) AttachBoard(fqbn, name string) {
+	m.CPU = BoardMetadata{Fqbn: fqbn, Name: name}
+	if m.Profiles == nil {
+		m.Profiles = map[string]*BuildProfile{}
+	}
+	profileName := m.DefaultProfileName()
+	if m.Profiles[profileName] == nil {
+		m.Profiles[profileName] = &BuildProfile{}
+	}
+	m.Profiles[profileName].Fqbn = fqbn
+}
+
 // NewSketchBook returns a new SketchBook object
 func NewSketchBook(path *paths.Path) *SketchBook {
 	return &SketchBook{
@@ -0,0 +1,95 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package sketches
+
+import (
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildProfiles(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "sketch-profiles-test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	require.NoError(t, tmp.Join("sketch.json").WriteFile([]byte(`{
+  "cpu": { "fqbn": "arduino:avr:uno" },
+  "default_profile": "debug",
+  "profiles": {
+    "debug": { "fqbn": "arduino:avr:nano:cpu=atmega328old", "warnings": "all" },
+    "release": {
+      "fqbn": "arduino:samd:mkr1000",
+      "build_properties": [ "build.extra_flags=-DRELEASE" ],
+      "platforms": { "arduino:samd": "1.8.4" },
+      "libraries": { "Servo": "1.1.6" }
+    }
+  }
+}`)))
+	sketch, err := NewSketchFromPath(tmp)
+	require.NoError(t, err)
+
+	profile, err := sketch.Metadata.Profile("")
+	require.NoError(t, err)
+	require.Equal(t, "arduino:avr:nano:cpu=atmega328old", profile.Fqbn)
+	require.Equal(t, "all", profile.Warnings)
+
+	profile, err = sketch.Metadata.Profile("release")
+	require.NoError(t, err)
+	require.Equal(t, []string{"build.extra_flags=-DRELEASE"}, profile.BuildProperties)
+	require.Equal(t, map[string]string{"arduino:samd": "1.8.4"}, profile.Platforms)
+	require.Equal(t, map[string]string{"Servo": "1.1.6"}, profile.Libraries)
+
+	_, err = sketch.Metadata.Profile("missing")
+	require.Error(t, err)
+	_, err = sketch.Metadata.Fqbn("missing")
+	require.Error(t, err)
+
+	fqbn, err := sketch.Metadata.Fqbn("release")
+	require.NoError(t, err)
+	require.Equal(t, "arduino:samd:mkr1000", fqbn)
+
+	// attaching a board changes only the FQBN of the default profile
+	sketch.Metadata.AttachBoard("arduino:avr:mega", "Arduino Mega")
+	require.NoError(t, sketch.ExportMetadata())
+	sketch, err = NewSketchFromPath(tmp)
+	require.NoError(t, err)
+	require.Equal(t, "arduino:avr:mega", sketch.Metadata.CPU.Fqbn)
+	require.Equal(t, "arduino:avr:mega", sketch.Metadata.Profiles["debug"].Fqbn)
+	require.Equal(t, "all", sketch.Metadata.Profiles["debug"].Warnings)
+	require.Equal(t, "arduino:samd:mkr1000", sketch.Metadata.Profiles["release"].Fqbn)
+}
+
+func TestBuildProfilesWithoutProfiles(t *testing.T) {
+	metadata := &Metadata{CPU: BoardMetadata{Fqbn: "arduino:avr:uno"}}
+	profile, err := metadata.Profile("")
+	require.NoError(t, err)
+	require.Nil(t, profile)
+	fqbn, err := metadata.Fqbn("")
+	require.NoError(t, err)
+	require.Equal(t, "arduino:avr:uno", fqbn)
+
+	metadata.AttachBoard("arduino:avr:mega", "")
+	require.Equal(t, "arduino:avr:mega", metadata.Profiles[DefaultProfileName].Fqbn)
+
+	var nilMetadata *Metadata
+	fqbn, err = nilMetadata.Fqbn("")
+	require.NoError(t, err)
+	require.Equal(t, "", fqbn)
+}
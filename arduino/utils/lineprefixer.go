@@ -0,0 +1,75 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// LinePrefixer is a writer that adds a prefix at the beginning of each line,
+// incomplete lines are kept until completed or flushed. The LinePrefixers
+// writing concurrently on the same output should share the same mutex to
+// keep their lines together.
+type LinePrefixer struct {
+	out    io.Writer
+	prefix string
+	mux    *sync.Mutex
+	line   []byte
+}
+
+// NewLinePrefixer creates a LinePrefixer writing on out, if mux is nil the
+// LinePrefixer uses its own mutex
+func NewLinePrefixer(out io.Writer, prefix string, mux *sync.Mutex) *LinePrefixer {
+	if mux == nil {
+		mux = &sync.Mutex{}
+	}
+	return &LinePrefixer{out: out, prefix: prefix, mux: mux}
+}
+
+func (l *LinePrefixer) Write(data []byte) (int, error) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	for _, c := range data {
+		l.line = append(l.line, c)
+		if c == '\n' {
+			l.flush()
+		}
+	}
+	return len(data), nil
+}
+
+// Flush writes the incomplete line, if any
+func (l *LinePrefixer) Flush() {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	l.flush()
+}
+
+func (l *LinePrefixer) flush() {
+	if len(l.line) == 0 {
+		return
+	}
+	if !bytes.HasSuffix(l.line, []byte("\n")) {
+		l.line = append(l.line, '\n')
+	}
+	fmt.Fprint(l.out, l.prefix+string(l.line))
+	l.line = l.line[:0]
+}
@@ -0,0 +1,35 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinePrefixer(t *testing.T) {
+	out := &bytes.Buffer{}
+	l := NewLinePrefixer(out, "[uno] ", nil)
+	l.Write([]byte("first line\nsecond "))
+	l.Write([]byte("line\nincomplete"))
+	require.Equal(t, "[uno] first line\n[uno] second line\n", out.String())
+	l.Flush()
+	require.Equal(t, "[uno] first line\n[uno] second line\n[uno] incomplete\n", out.String())
+}
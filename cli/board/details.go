@@ -74,6 +74,10 @@ func (dr detailsResult) String() string {
 	//                 arduino:avrdude                 6.3.0-arduino14
 	//                 arduino:arduinoOTA              1.2.1
 	//
+	// Programmers:    Id                              Name
+	//                 avrisp                          AVR ISP
+	//                 usbasp                          USBasp
+	//
 	// Option:         Processor                       cpu
 	//                 ATmega328P                    ✔ cpu=atmega328
 	//                 ATmega328P (Old Bootloader)     cpu=atmega328old
@@ -91,6 +95,14 @@ func (dr detailsResult) String() string {
 		t.AddRow("", tool.Packager+":"+tool.Name, "", tool.Version)
 	}
 
+	for i, programmer := range details.Programmers {
+		if i == 0 {
+			t.AddRow() // get some space from above
+			t.AddRow("Programmers:", "Id", "", "Name")
+		}
+		t.AddRow("", programmer.Id, "", programmer.Name)
+	}
+
 	for _, option := range details.ConfigOptions {
 		t.AddRow() // get some space from above
 		t.AddRow("Option:", option.OptionLabel, "", option.Option)
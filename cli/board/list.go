@@ -18,15 +18,19 @@
 package board
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/commands/board"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
 	"github.com/arduino/arduino-cli/table"
@@ -45,23 +49,34 @@ func initListCommand() *cobra.Command {
 
 	listCommand.Flags().StringVar(&listFlags.timeout, "timeout", "0s",
 		"The timeout of the search of connected devices, try to increase it if your board is not found (e.g. to 10s).")
+	listCommand.Flags().BoolVarP(&listFlags.watch, "watch", "w", false,
+		"Keep running and print an event each time a board is connected or disconnected.")
 	return listCommand
 }
 
 var listFlags struct {
 	timeout string // Expressed in a parsable duration, is the timeout for the list and attach commands.
+	watch   bool   // Watch for boards being connected or disconnected instead of listing them.
 }
 
 // runListCommand detects and lists the connected arduino boards
 func runListCommand(cmd *cobra.Command, args []string) {
+	if listFlags.watch {
+		watchList(instance.CreateInstance().GetId())
+		return
+	}
+
 	if timeout, err := time.ParseDuration(listFlags.timeout); err != nil {
 		feedback.Errorf("Invalid timeout: %v", err)
 		os.Exit(errorcodes.ErrBadArgument)
 	} else {
+		// the network boards are browsed while waiting
+		commands.StartNetworkDiscovery()
 		time.Sleep(timeout)
 	}
 
 	ports, err := board.List(instance.CreateInstance().GetId())
+	commands.StopNetworkDiscovery()
 	if err != nil {
 		feedback.Errorf("Error detecting boards: %v", err)
 		os.Exit(errorcodes.ErrNetwork)
@@ -70,6 +85,63 @@ func runListCommand(cmd *cobra.Command, args []string) {
 	feedback.PrintResult(result{ports})
 }
 
+// watchList prints the boards being connected or disconnected until
+// the user interrupts the command
+func watchList(instanceID int32) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		<-interrupt
+		cancel()
+	}()
+
+	events, err := board.Watch(ctx, instanceID)
+	if err != nil {
+		feedback.Errorf("Error detecting boards: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	for event := range events {
+		feedback.PrintResult(watchEvent{event})
+	}
+}
+
+type watchEvent struct {
+	event *rpc.BoardListWatchResp
+}
+
+func (we watchEvent) Data() interface{} {
+	return we.event
+}
+
+func (we watchEvent) String() string {
+	port := we.event.GetPort()
+	address := port.GetProtocol() + "://" + port.GetAddress()
+	if port.GetProtocol() == "serial" {
+		address = port.GetAddress()
+	}
+
+	if we.event.GetEventType() == "remove" {
+		return fmt.Sprintf("Port %s disconnected", address)
+	}
+
+	boards := []string{}
+	for _, b := range port.GetBoards() {
+		boards = append(boards, fmt.Sprintf("%s (%s)", b.GetName(), b.GetFQBN()))
+	}
+	if len(boards) == 0 {
+		boards = append(boards, "Unknown")
+	}
+	res := fmt.Sprintf("Port %s connected: %s", address, strings.Join(boards, ", "))
+	if we.event.GetError() != "" {
+		res += "\n  Error identifying board: " + we.event.GetError()
+	}
+	return res
+}
+
 // output from this command requires special formatting, let's create a dedicated
 // feedback.Result implementation
 type result struct {
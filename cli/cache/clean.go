@@ -0,0 +1,49 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package cache
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initCleanCommand() *cobra.Command {
+	cleanCommand := &cobra.Command{
+		Use:     "clean",
+		Short:   "Delete the object files cache.",
+		Long:    "Delete all the object files cached by the previous builds.",
+		Example: "  " + os.Args[0] + " cache clean",
+		Args:    cobra.NoArgs,
+		Run:     runCleanCommand,
+	}
+	return cleanCommand
+}
+
+func runCleanCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino cache clean`")
+
+	if err := objectCache().Clean(); err != nil {
+		feedback.Errorf("Error cleaning cache: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	logrus.Info("Done")
+}
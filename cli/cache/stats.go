@@ -0,0 +1,92 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package cache
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/builder"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initStatsCommand() *cobra.Command {
+	statsCommand := &cobra.Command{
+		Use:     "stats",
+		Short:   "Show the object files cache statistics.",
+		Long:    "Show the location, the number of entries and the size of the object files cache.",
+		Example: "  " + os.Args[0] + " cache stats",
+		Args:    cobra.NoArgs,
+		Run:     runStatsCommand,
+	}
+	return statsCommand
+}
+
+func runStatsCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino cache stats`")
+
+	stats, err := objectCache().Stats()
+	if err != nil {
+		feedback.Errorf("Error reading cache: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	feedback.PrintResult(statsResult{stats})
+}
+
+// output from this command requires special formatting, let's create a dedicated
+// feedback.Result implementation
+type statsResult struct {
+	stats *builder.ObjectCacheStats
+}
+
+func (sr statsResult) Data() interface{} {
+	return sr.stats
+}
+
+func (sr statsResult) String() string {
+	maxSize := "unlimited"
+	if sr.stats.MaxSize > 0 {
+		maxSize = formatSize(sr.stats.MaxSize)
+	}
+	t := table.New()
+	t.AddRow("Directory:", sr.stats.Dir)
+	t.AddRow("Entries:", sr.stats.Entries)
+	t.AddRow("Objects:", sr.stats.Objects)
+	t.AddRow("Size:", formatSize(sr.stats.Size))
+	t.AddRow("Max size:", maxSize)
+	return t.Render()
+}
+
+// formatSize returns the size in a human readable form, e.g.: 12.3 MiB
+func formatSize(size int64) string {
+	units := []string{"B", "KiB", "MiB", "GiB"}
+	value := float64(size)
+	unit := 0
+	for value >= 1024 && unit < len(units)-1 {
+		value /= 1024
+		unit++
+	}
+	if unit == 0 {
+		return fmt.Sprintf("%d %s", size, units[unit])
+	}
+	return fmt.Sprintf("%.1f %s", value, units[unit])
+}
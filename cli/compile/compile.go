@@ -19,6 +19,7 @@ package compile
 
 import (
 	"context"
+	"io"
 	"os"
 
 	"github.com/arduino/arduino-cli/cli/feedback"
@@ -26,8 +27,8 @@ import (
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
 	"github.com/arduino/arduino-cli/commands/compile"
-	"github.com/arduino/arduino-cli/commands/upload"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
 	"github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
@@ -35,7 +36,7 @@ import (
 )
 
 var (
-	fqbn               string   // Fully Qualified Board Name, e.g.: arduino:avr:uno.
+	fqbn               []string // Fully Qualified Board Names, e.g.: arduino:avr:uno.
 	showProperties     bool     // Show all build preferences used instead of compiling.
 	preprocess         bool     // Print preprocessed code to stdout.
 	buildCachePath     string   // Builds of 'core.a' are saved into this path to be cached and reused.
@@ -48,7 +49,10 @@ var (
 	uploadAfterCompile bool     // Upload the binary after the compilation.
 	port               string   // Upload port, e.g.: COM10 or /dev/ttyACM0.
 	verify             bool     // Upload, verify uploaded binary after the upload.
+	programmer         string   // Upload, use the specified programmer.
 	exportFile         string   // The compiled binary is written to this file
+	compilationDBOnly  bool     // Only create the compilation database.
+	profile            string   // The build profile of the sketch to use.
 )
 
 // NewCommand created a new `compile` command
@@ -62,7 +66,7 @@ func NewCommand() *cobra.Command {
 		Run:     run,
 	}
 
-	command.Flags().StringVarP(&fqbn, "fqbn", "b", "", "Fully Qualified Board Name, e.g.: arduino:avr:uno")
+	command.Flags().StringArrayVarP(&fqbn, "fqbn", "b", []string{}, "Fully Qualified Board Name, e.g.: arduino:avr:uno. Can be used multiple times to compile for several boards.")
 	command.Flags().BoolVar(&showProperties, "show-properties", false, "Show all build properties used instead of compiling.")
 	command.Flags().BoolVar(&preprocess, "preprocess", false, "Print preprocessed code to stdout instead of compiling.")
 	command.Flags().StringVar(&buildCachePath, "build-cache-path", "", "Builds of 'core.a' are saved into this path to be cached and reused.")
@@ -78,6 +82,9 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVarP(&uploadAfterCompile, "upload", "u", false, "Upload the binary after the compilation.")
 	command.Flags().StringVarP(&port, "port", "p", "", "Upload port, e.g.: COM10 or /dev/ttyACM0")
 	command.Flags().BoolVarP(&verify, "verify", "t", false, "Verify uploaded binary after the upload.")
+	command.Flags().StringVarP(&programmer, "programmer", "P", "", "Optional, use the specified programmer to upload.")
+	command.Flags().BoolVar(&compilationDBOnly, "only-compilation-database", false, "Just produce the compilation database (compile_commands.json), without actually compiling.")
+	command.Flags().StringVar(&profile, "profile", "", "Use the given build profile of the sketch (board, build properties and warnings level).")
 	command.Flags().StringVar(&vidPid, "vid-pid", "", "When specified, VID/PID specific build properties are used, if boards supports them.")
 
 	return command
@@ -93,43 +100,78 @@ func run(cmd *cobra.Command, args []string) {
 
 	sketchPath := initSketchPath(path)
 
-	_, err := compile.Compile(context.Background(), &rpc.CompileReq{
-		Instance:        instance,
-		Fqbn:            fqbn,
-		SketchPath:      sketchPath.String(),
-		ShowProperties:  showProperties,
-		Preprocess:      preprocess,
-		BuildCachePath:  buildCachePath,
-		BuildPath:       buildPath,
-		BuildProperties: buildProperties,
-		Warnings:        warnings,
-		Verbose:         verbose,
-		Quiet:           quiet,
-		VidPid:          vidPid,
-		ExportFile:      exportFile,
-	}, os.Stdout, os.Stderr, globals.Config, globals.LogLevel == "debug")
+	// the progress of the tasks is shown only when uploading, to leave
+	// the output of a plain compilation unchanged
+	taskCB := output.NewNullTaskProgressCB()
+	if uploadAfterCompile {
+		taskCB = output.TaskProgress()
+	}
+
+	// with JSON output the stdout is reserved for the diagnostics and the
+	// result of the build
+	jsonOutput := output.OutputFormat == "json" && !showProperties && !preprocess
+	var outStream io.Writer = os.Stdout
+	if jsonOutput {
+		outStream = os.Stderr
+	}
+	diagnostics := []*rpc.CompileDiagnostic{}
+	diagnosticsCB := func(d []*rpc.CompileDiagnostic) { diagnostics = d }
+
+	// with several boards the FQBNs are passed in a separate field
+	fqbnIn := ""
+	var fqbns []string
+	if len(fqbn) == 1 {
+		fqbnIn = fqbn[0]
+	} else if len(fqbn) > 1 {
+		fqbns = fqbn
+	}
 
+	// the warnings level of the build profile (the default one if --profile
+	// is not given) is used, unless the flag is explicitly set
+	warningsLevel := warnings
+	if !cmd.Flags().Changed("warnings") {
+		warningsLevel = ""
+	}
+
+	res, err := compile.Compile(context.Background(), &rpc.CompileReq{
+		Instance:                      instance,
+		Fqbn:                          fqbnIn,
+		Fqbns:                         fqbns,
+		SketchPath:                    sketchPath.String(),
+		ShowProperties:                showProperties,
+		Preprocess:                    preprocess,
+		BuildCachePath:                buildCachePath,
+		BuildPath:                     buildPath,
+		BuildProperties:               buildProperties,
+		Warnings:                      warningsLevel,
+		Verbose:                       verbose,
+		Quiet:                         quiet,
+		VidPid:                        vidPid,
+		ExportFile:                    exportFile,
+		Upload:                        uploadAfterCompile,
+		Port:                          port,
+		Verify:                        verify,
+		Programmer:                    programmer,
+		CreateCompilationDatabaseOnly: compilationDBOnly,
+		Profile:                       profile,
+	}, outStream, os.Stderr, taskCB, diagnosticsCB, globals.Config, globals.LogLevel == "debug")
+
+	if jsonOutput {
+		feedback.Print(compileOutput{Diagnostics: diagnostics, Result: res.GetResult(), BoardResults: res.GetBoardResults()})
+	} else if len(res.GetBoardResults()) > 0 {
+		feedback.PrintResult(multiCompileResult{res.GetBoardResults()})
+	}
 	if err != nil {
 		feedback.Errorf("Error during build: %v", err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
+}
 
-	if uploadAfterCompile {
-		_, err := upload.Upload(context.Background(), &rpc.UploadReq{
-			Instance:   instance,
-			Fqbn:       fqbn,
-			SketchPath: sketchPath.String(),
-			Port:       port,
-			Verbose:    verbose,
-			Verify:     verify,
-			ImportFile: exportFile,
-		}, os.Stdout, os.Stderr)
-
-		if err != nil {
-			feedback.Errorf("Error during Upload: %v", err)
-			os.Exit(errorcodes.ErrGeneric)
-		}
-	}
+// compileOutput is the output of the command in JSON format
+type compileOutput struct {
+	Diagnostics  []*rpc.CompileDiagnostic  `json:"diagnostics"`
+	Result       *rpc.CompileResult        `json:"result,omitempty"`
+	BoardResults []*rpc.BoardCompileResult `json:"board_results,omitempty"`
 }
 
 // initSketchPath returns the current working directory
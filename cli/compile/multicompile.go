@@ -0,0 +1,67 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package compile
+
+import (
+	"fmt"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/arduino-cli/table"
+)
+
+// output from a compilation for several boards requires special formatting,
+// let's create a dedicated feedback.Result implementation
+type multiCompileResult struct {
+	results []*rpc.BoardCompileResult
+}
+
+func (mr multiCompileResult) Data() interface{} {
+	return mr.results
+}
+
+func (mr multiCompileResult) String() string {
+	t := table.New()
+	t.SetHeader("FQBN", "Result", "Program size", "Data size", "Warnings")
+	for _, res := range mr.results {
+		size := res.GetResult().GetExecutableSize()
+		program := formatSize(size.GetTextSize(), size.GetMaxTextSize())
+		data := formatSize(size.GetDataSize(), size.GetMaxDataSize())
+		if size == nil {
+			program, data = "-", "-"
+		}
+		result := "OK"
+		if !res.GetSuccess() {
+			result = res.GetError()
+			program, data = "", ""
+		}
+		t.AddRow(res.GetFqbn(), result, program, data, res.GetWarnings())
+	}
+	return t.Render()
+}
+
+// formatSize returns the used size in bytes and in percentage of the
+// maximum, e.g.: "924 (2%)"
+func formatSize(size, max int64) string {
+	if size < 0 {
+		return "-"
+	}
+	if max <= 0 {
+		return fmt.Sprintf("%d", size)
+	}
+	return fmt.Sprintf("%d (%d%%)", size, size*100/max)
+}
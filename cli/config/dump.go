@@ -37,6 +37,13 @@ type jsonConfig struct {
 	ArduinoDataDir      string                   `json:"arduino_data,omitempty"`
 	ArduinoDownloadsDir string                   `json:"arduino_downloads_dir,omitempty"`
 	BoardsManager       *jsonBoardsManagerConfig `json:"board_manager"`
+	BuildCache          *jsonBuildCacheConfig    `json:"build_cache"`
+}
+
+type jsonBuildCacheConfig struct {
+	Path      string `json:"path"`
+	MaxSizeMB int64  `json:"max_size_mb"`
+	Disabled  bool   `json:"disabled"`
 }
 
 type jsonBoardsManagerConfig struct {
@@ -113,6 +120,11 @@ func runDumpCommand(cmd *cobra.Command, args []string) {
 			BoardsManager: &jsonBoardsManagerConfig{
 				AdditionalURLS: c.BoardManagerAdditionalUrls,
 			},
+			BuildCache: &jsonBuildCacheConfig{
+				Path:      c.BuildCacheDir().String(),
+				MaxSizeMB: c.BuildCacheMaxSize / (1024 * 1024),
+				Disabled:  c.BuildCacheDisabled,
+			},
 		},
 		plain: string(data),
 	})
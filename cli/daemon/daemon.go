@@ -69,7 +69,9 @@ func runDaemonCommand(cmd *cobra.Command, args []string) {
 	srv_commands.RegisterArduinoCoreServer(s, &coreServer)
 
 	// register the monitors service
-	srv_monitor.RegisterMonitorServer(s, &daemon.MonitorService{})
+	srv_monitor.RegisterMonitorServer(s, &daemon.MonitorService{
+		RecordsDir: globals.Config.MonitorRecordsDir(),
+	})
 
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
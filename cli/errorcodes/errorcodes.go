@@ -28,4 +28,7 @@ const (
 	// directories vital for the CLI to work.
 	ErrCoreConfig
 	ErrBadArgument
+	// ErrTimeout is used when an operation, like the upload of a sketch,
+	// doesn't complete within the allowed time.
+	ErrTimeout
 )
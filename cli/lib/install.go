@@ -42,9 +42,14 @@ func initInstallCommand() *cobra.Command {
 		Args: cobra.MinimumNArgs(1),
 		Run:  runInstallCommand,
 	}
+	installCommand.Flags().BoolVar(&installFlags.noDeps, "no-deps", false, "Do not install dependencies.")
 	return installCommand
 }
 
+var installFlags struct {
+	noDeps bool
+}
+
 func runInstallCommand(cmd *cobra.Command, args []string) {
 	instance := instance.CreateInstaceIgnorePlatformIndexErrors()
 	refs, err := globals.ParseReferenceArgs(args, false)
@@ -58,6 +63,7 @@ func runInstallCommand(cmd *cobra.Command, args []string) {
 			Instance: instance,
 			Name:     library.PackageName,
 			Version:  library.Version,
+			NoDeps:   installFlags.noDeps,
 		}
 		err := lib.LibraryInstall(context.Background(), libraryInstallReq, output.ProgressBar(),
 			output.TaskProgress(), globals.NewHTTPClientHeader())
@@ -0,0 +1,187 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package monitor
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/monitors"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/spf13/cobra"
+)
+
+var (
+	port       string
+	config     []string
+	lineEnding string
+	echo       bool
+	timestamp  bool
+	hexDump    bool
+	raw        bool
+	recordFile string
+)
+
+// NewCommand created a new `monitor` command
+func NewCommand() *cobra.Command {
+	monitorCommand := &cobra.Command{
+		Use:   "monitor",
+		Short: "Open a communication port with a board.",
+		Long:  "Open a communication port with a board, the data received is printed on the standard output and the standard input is sent to the board.",
+		Example: "  " + os.Args[0] + " monitor -p /dev/ttyACM0\n" +
+			"  " + os.Args[0] + " monitor -p /dev/ttyACM0 -c baudrate=115200 --line-ending nlcr\n" +
+			"  " + os.Args[0] + " monitor -p tcp://192.168.1.10:23 --raw > log.txt\n" +
+			"  " + os.Args[0] + " monitor -p /dev/ttyACM0 --record session.log\n" +
+			"  " + os.Args[0] + " monitor -p replay://session.log -c speed=10",
+		Args: cobra.NoArgs,
+		Run:  run,
+	}
+
+	monitorCommand.Flags().StringVarP(&port, "port", "p", "", "Port to open, e.g.: COM10, /dev/ttyACM0 or tcp://192.168.1.10:23")
+	monitorCommand.Flags().StringSliceVarP(&config, "config", "c", []string{}, "Configuration of the port, e.g.: baudrate=115200,databits=7,parity=even,stopbits=1,dtr=false")
+	monitorCommand.Flags().StringVar(&lineEnding, "line-ending", "nl", "Line ending appended to the lines sent to the board: none, nl, cr or nlcr")
+	monitorCommand.Flags().BoolVar(&echo, "echo", false, "Print the lines sent to the board (not available with --hex).")
+	monitorCommand.Flags().BoolVar(&timestamp, "timestamp", false, "Print a timestamp at the beginning of each line.")
+	monitorCommand.Flags().BoolVar(&hexDump, "hex", false, "Print the data received as an hex dump.")
+	monitorCommand.Flags().BoolVar(&raw, "raw", false, "Pass the data through unmodified and don't print any other message.")
+	monitorCommand.Flags().StringVar(&recordFile, "record", "", "Record the session in the specified file, it can be replayed later using replay://FILE as port.")
+	monitorCommand.MarkFlagRequired("port")
+
+	return monitorCommand
+}
+
+func run(command *cobra.Command, args []string) {
+	ending, ok := lineEndings[lineEnding]
+	if !ok {
+		feedback.Errorf("Invalid line ending: %s", lineEnding)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if raw && (echo || timestamp || hexDump) {
+		feedback.Error("The --raw flag can't be used together with --echo, --timestamp or --hex")
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if echo && hexDump {
+		// the echoed lines would break the alignment of the hex dump
+		feedback.Error("The --echo flag can't be used together with --hex")
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	monitorConfig, err := parseConfig(config)
+	if err != nil {
+		feedback.Errorf("Invalid port configuration: %v", err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+
+	protocol, address := commands.ParsePortAddress(port)
+	mon, err := monitors.Open(protocol, address, monitorConfig)
+	if err != nil {
+		feedback.Errorf("Error opening port %s: %v", port, err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	if recordFile != "" {
+		capture, err := os.Create(recordFile)
+		if err != nil {
+			mon.Close()
+			feedback.Errorf("Error creating record file: %v", err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		mon = monitors.NewRecordingMonitor(mon, capture)
+	}
+	defer mon.Close()
+
+	out := newOutput(os.Stdout, timestamp, hexDump)
+	if !raw {
+		fmt.Fprintf(os.Stderr, "Connected to %s, press CTRL-C to exit\n", port)
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	targetClosed := make(chan error, 1)
+
+	// send the standard input to the board
+	go func() {
+		if raw {
+			io.Copy(mon, os.Stdin)
+			return
+		}
+		in := bufio.NewReader(os.Stdin)
+		for {
+			line, err := in.ReadString('\n')
+			if line != "" {
+				line = strings.TrimRight(line, "\r\n") + ending
+				if _, err := mon.Write([]byte(line)); err != nil {
+					targetClosed <- err
+					return
+				}
+				if echo {
+					out.Echo([]byte(line))
+				}
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	// print the data received from the board
+	go func() {
+		_, err := io.Copy(out, mon)
+		targetClosed <- err
+	}()
+
+	select {
+	case <-interrupt:
+	case err := <-targetClosed:
+		if err != nil && !raw {
+			feedback.Errorf("Port %s closed: %v", port, err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+	}
+	out.Flush()
+}
+
+var lineEndings = map[string]string{
+	"none": "",
+	"nl":   "\n",
+	"cr":   "\r",
+	"nlcr": "\r\n",
+}
+
+// parseConfig converts the key=value pairs passed with the --config flag
+// to the configuration map expected by the monitors
+func parseConfig(config []string) (map[string]interface{}, error) {
+	res := map[string]interface{}{}
+	for _, c := range config {
+		split := strings.SplitN(c, "=", 2)
+		if len(split) != 2 || split[0] == "" {
+			return nil, fmt.Errorf("expected KEY=VALUE: %s", c)
+		}
+		if n, err := strconv.Atoi(split[1]); err == nil {
+			res[split[0]] = n
+		} else {
+			res[split[0]] = split[1]
+		}
+	}
+	return res, nil
+}
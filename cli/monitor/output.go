@@ -0,0 +1,98 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package monitor
+
+import (
+	"encoding/hex"
+	"io"
+	"sync"
+	"time"
+)
+
+// output formats the data received from the board before printing it.
+// Writes coming from the board and echoed lines may happen concurrently,
+// so all the operations are serialized.
+type output struct {
+	mux    sync.Mutex
+	out    io.Writer
+	dumper io.WriteCloser
+}
+
+func newOutput(out io.Writer, timestamp bool, hexDump bool) *output {
+	if timestamp {
+		out = &timestampWriter{out: out, now: time.Now, lineStart: true}
+	}
+	res := &output{out: out}
+	if hexDump {
+		res.dumper = hex.Dumper(out)
+	}
+	return res
+}
+
+// Write the data received from the board
+func (o *output) Write(data []byte) (int, error) {
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	if o.dumper != nil {
+		return o.dumper.Write(data)
+	}
+	return o.out.Write(data)
+}
+
+// Echo prints the data sent to the board, it can't be used with the hex
+// dump
+func (o *output) Echo(data []byte) {
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	o.out.Write(data)
+}
+
+// Flush prints the last incomplete line of the hex dump
+func (o *output) Flush() {
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	if o.dumper != nil {
+		o.dumper.Close()
+		o.dumper = nil
+	}
+}
+
+// timestampWriter prefixes each line written with the current time
+type timestampWriter struct {
+	out       io.Writer
+	now       func() time.Time
+	lineStart bool
+}
+
+func (w *timestampWriter) Write(data []byte) (int, error) {
+	buf := []byte{}
+	for _, b := range data {
+		if w.lineStart {
+			buf = append(buf, w.now().Format("15:04:05.000 -> ")...)
+			w.lineStart = false
+		}
+		buf = append(buf, b)
+		if b == '\n' {
+			w.lineStart = true
+		}
+	}
+	if _, err := w.out.Write(buf); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
@@ -0,0 +1,55 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package monitor
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimestampWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := &timestampWriter{
+		out:       buf,
+		now:       func() time.Time { return time.Date(2019, 9, 1, 10, 20, 30, 0, time.UTC) },
+		lineStart: true,
+	}
+	w.Write([]byte("Hello\nWor"))
+	w.Write([]byte("ld\n"))
+	require.Equal(t, "10:20:30.000 -> Hello\n10:20:30.000 -> World\n", buf.String())
+}
+
+func TestHexOutput(t *testing.T) {
+	buf := &bytes.Buffer{}
+	out := newOutput(buf, false, true)
+	out.Write([]byte("Hello"))
+	out.Flush()
+	require.Equal(t, "00000000  48 65 6c 6c 6f                                    |Hello|\n", buf.String())
+}
+
+func TestParseConfig(t *testing.T) {
+	config, err := parseConfig([]string{"baudrate=115200", "parity=none"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"baudrate": 115200, "parity": "none"}, config)
+
+	_, err = parseConfig([]string{"baudrate"})
+	require.Error(t, err)
+}
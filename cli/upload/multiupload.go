@@ -0,0 +1,87 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package upload
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/commands/upload"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/arduino-cli/table"
+)
+
+// runMultiUpload uploads on all the given ports in parallel, the output
+// of each upload is printed line by line prefixed with the port
+func runMultiUpload(req *rpc.UploadReq) {
+	outputs := map[string]*utils.LinePrefixer{}
+	errOutputs := map[string]*utils.LinePrefixer{}
+	results := []*rpc.MultiUploadResp{}
+	for _, port := range ports {
+		outputs[port] = utils.NewLinePrefixer(os.Stdout, "["+port+"] ", nil)
+		errOutputs[port] = utils.NewLinePrefixer(os.Stderr, "["+port+"] ", nil)
+	}
+
+	err := upload.MultiUpload(context.Background(), &rpc.MultiUploadReq{Upload: req, Ports: ports},
+		func(resp *rpc.MultiUploadResp) {
+			port := resp.GetPort()
+			outputs[port].Write(resp.GetUpload().GetOutStream())
+			errOutputs[port].Write(resp.GetUpload().GetErrStream())
+			if resp.GetCompleted() {
+				outputs[port].Flush()
+				errOutputs[port].Flush()
+				results = append(results, resp)
+			}
+		})
+
+	feedback.PrintResult(multiUploadResult{results})
+	if err != nil {
+		feedback.Errorf("Error during Upload: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
+
+// output from a multi upload requires special formatting, let's create a
+// dedicated feedback.Result implementation
+type multiUploadResult struct {
+	results []*rpc.MultiUploadResp
+}
+
+func (mr multiUploadResult) Data() interface{} {
+	return mr.results
+}
+
+func (mr multiUploadResult) String() string {
+	out := ""
+	t := table.New()
+	t.SetHeader("Port", "Result")
+	for _, res := range mr.results {
+		if dryRun && res.GetUpload() != nil {
+			out += "Port " + res.GetPort() + "\n" + dryRunResult{res.GetUpload()}.String() + "\n\n"
+		}
+		if res.GetError() != "" {
+			t.AddRow(res.GetPort(), res.GetError())
+		} else {
+			t.AddRow(res.GetPort(), "OK")
+		}
+	}
+	return out + t.Render()
+}
@@ -19,7 +19,11 @@ package upload
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
@@ -33,28 +37,48 @@ import (
 
 var (
 	fqbn       string
-	port       string
+	ports      []string
 	verbose    bool
 	verify     bool
 	importFile string
+	programmer string
+	password   string
+	importDir  string
+	dryRun     bool
+	timeout    time.Duration
+	readback   string
+	verifyRead bool
+	profile    string
 )
 
 // NewCommand created a new `upload` command
 func NewCommand() *cobra.Command {
 	uploadCommand := &cobra.Command{
-		Use:     "upload",
-		Short:   "Upload Arduino sketches.",
-		Long:    "Upload Arduino sketches.",
-		Example: "  " + os.Args[0] + " upload /home/user/Arduino/MySketch",
-		Args:    cobra.MaximumNArgs(1),
-		Run:     run,
+		Use:   "upload",
+		Short: "Upload Arduino sketches.",
+		Long:  "Upload Arduino sketches.",
+		Example: "  " + os.Args[0] + " upload /home/user/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " upload -i firmware.hex -b arduino:avr:uno -p /dev/ttyACM0\n" +
+			"  " + os.Args[0] + " upload -b arduino:samd:mkr1000 -p /dev/ttyACM0 -p /dev/ttyACM1 /home/user/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " upload -b arduino:avr:uno -p /dev/ttyACM0 --readback firmware.hex",
+		Args: cobra.MaximumNArgs(1),
+		Run:  run,
 	}
 
 	uploadCommand.Flags().StringVarP(&fqbn, "fqbn", "b", "", "Fully Qualified Board Name, e.g.: arduino:avr:uno")
-	uploadCommand.Flags().StringVarP(&port, "port", "p", "", "Upload port, e.g.: COM10 or /dev/ttyACM0")
+	uploadCommand.Flags().StringVar(&profile, "profile", "", "Use the board of the given build profile of the sketch.")
+	uploadCommand.Flags().StringArrayVarP(&ports, "port", "p", []string{},
+		"Upload port, e.g.: COM10, /dev/ttyACM0 or 192.168.1.20:3232 for network upload. Can be used multiple times to upload on several boards in parallel.")
 	uploadCommand.Flags().StringVarP(&importFile, "input", "i", "", "Input file to be uploaded.")
+	uploadCommand.Flags().StringVar(&importDir, "input-dir", "", "Directory containing the binaries to upload.")
 	uploadCommand.Flags().BoolVarP(&verify, "verify", "t", false, "Verify uploaded binary after the upload.")
 	uploadCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, "Optional, turns on verbose mode.")
+	uploadCommand.Flags().StringVarP(&programmer, "programmer", "P", "", "Optional, use the specified programmer to upload.")
+	uploadCommand.Flags().StringVar(&password, "network-password", "", "Optional, password of the board for network upload.")
+	uploadCommand.Flags().DurationVar(&timeout, "timeout", 0, "Optional, kill the upload tool if it doesn't complete within the given time, e.g.: 90s.")
+	uploadCommand.Flags().StringVar(&readback, "readback", "", "Read the flash of the board into the given file (.hex or .bin) instead of uploading.")
+	uploadCommand.Flags().BoolVar(&verifyRead, "verify-readback", false, "Verify the upload by reading back the flash and comparing it with the uploaded binary.")
+	uploadCommand.Flags().BoolVar(&dryRun, "dry-run", false, "Print the upload command line and properties without running the upload tool.")
 
 	return uploadCommand
 }
@@ -62,26 +86,93 @@ func NewCommand() *cobra.Command {
 func run(command *cobra.Command, args []string) {
 	instance := instance.CreateInstance()
 
-	var path *paths.Path
+	// the sketch is not needed when uploading a prebuilt binary
+	sketchPath := ""
 	if len(args) > 0 {
-		path = paths.New(args[0])
+		sketchPath = args[0]
+	} else if importFile == "" && importDir == "" && readback == "" {
+		sketchPath = initSketchPath(nil).String()
 	}
-	sketchPath := initSketchPath(path)
-
-	_, err := upload.Upload(context.Background(), &rpc.UploadReq{
-		Instance:   instance,
-		Fqbn:       fqbn,
-		SketchPath: sketchPath.String(),
-		Port:       port,
-		Verbose:    verbose,
-		Verify:     verify,
-		ImportFile: importFile,
-	}, os.Stdout, os.Stderr)
 
-	if err != nil {
+	req := &rpc.UploadReq{
+		Instance:        instance,
+		Fqbn:            fqbn,
+		SketchPath:      sketchPath,
+		Verbose:         verbose,
+		Verify:          verify,
+		ImportFile:      importFile,
+		Programmer:      programmer,
+		NetworkPassword: password,
+		ImportDir:       importDir,
+		DryRun:          dryRun,
+		TimeoutMs:       int32(timeout / time.Millisecond),
+		ReadbackVerify:  verifyRead,
+		ReadbackFile:    readback,
+		Profile:         profile,
+	}
+
+	if len(ports) > 1 {
+		runMultiUpload(req)
+		return
+	}
+	if len(ports) == 1 {
+		req.Port = ports[0]
+	}
+
+	res, err := upload.Upload(context.Background(), req, os.Stdout, os.Stderr, func(*rpc.UploadProgress) {})
+
+	if err == upload.ErrUploadTimeout {
+		feedback.Errorf("Error during Upload: %v", err)
+		os.Exit(errorcodes.ErrTimeout)
+	} else if err != nil {
 		feedback.Errorf("Error during Upload: %v", err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
+
+	if dryRun {
+		feedback.PrintResult(dryRunResult{res})
+	}
+}
+
+// output from a dry run requires special formatting, let's create a
+// dedicated feedback.Result implementation
+type dryRunResult struct {
+	res *rpc.UploadResp
+}
+
+func (dr dryRunResult) Data() interface{} {
+	return dr.res
+}
+
+func (dr dryRunResult) String() string {
+	args := []string{}
+	for _, arg := range dr.res.CommandLine {
+		if arg == "" || strings.ContainsAny(arg, " \t\"'") {
+			arg = fmt.Sprintf("%q", arg)
+		}
+		args = append(args, arg)
+	}
+	out := "Command line:\n  " + strings.Join(args, " ") + "\n"
+
+	out += "\nTools:\n"
+	out += formatSortedMap(dr.res.ToolPaths, ": ")
+
+	out += "\nProperties:\n"
+	out += formatSortedMap(dr.res.Properties, "=")
+	return strings.TrimSuffix(out, "\n")
+}
+
+func formatSortedMap(m map[string]string, separator string) string {
+	keys := []string{}
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	out := ""
+	for _, key := range keys {
+		out += "  " + key + separator + m[key] + "\n"
+	}
+	return out
 }
 
 // initSketchPath returns the current working directory
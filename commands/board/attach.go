@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/url"
 	"strings"
 	"time"
@@ -30,7 +31,6 @@ import (
 	"github.com/arduino/arduino-cli/arduino/sketches"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
-	discovery "github.com/arduino/board-discovery"
 	paths "github.com/arduino/go-paths-helper"
 )
 
@@ -57,21 +57,15 @@ func Attach(ctx context.Context, req *rpc.BoardAttachReq, taskCB commands.TaskPr
 	}
 
 	if fqbn != nil {
-		sketch.Metadata.CPU = sketches.BoardMetadata{
-			Fqbn: fqbn.String(),
-		}
+		sketch.Metadata.AttachBoard(fqbn.String(), "")
 	} else {
 		deviceURI, err := url.Parse(boardURI)
 		if err != nil {
 			return nil, fmt.Errorf("invalid Device URL format: %s", err)
 		}
 
-		var findBoardFunc func(*packagemanager.PackageManager, *discovery.Monitor, *url.URL) *cores.Board
 		switch deviceURI.Scheme {
-		case "serial", "tty":
-			findBoardFunc = findSerialConnectedBoard
-		case "http", "https", "tcp", "udp":
-			findBoardFunc = findNetworkConnectedBoard
+		case "serial", "tty", "http", "https", "tcp", "udp":
 		default:
 			return nil, fmt.Errorf("invalid device port type provided")
 		}
@@ -81,24 +75,17 @@ func Attach(ctx context.Context, req *rpc.BoardAttachReq, taskCB commands.TaskPr
 			duration = time.Second * 5
 		}
 
-		monitor := discovery.New(time.Second)
-		monitor.Start()
-
-		time.Sleep(duration)
+		ports, err := discoverPorts(ctx, pm, duration)
+		if err != nil {
+			return nil, fmt.Errorf("searching for boards: %s", err)
+		}
 
-		// TODO: Handle the case when no board is found.
-		board := findBoardFunc(pm, monitor, deviceURI)
+		board := findConnectedBoard(pm, ports, deviceURI)
 		if board == nil {
 			return nil, fmt.Errorf("no supported board found at %s", deviceURI.String())
 		}
 		taskCB(&rpc.TaskProgress{Name: "Board found: " + board.Name()})
-
-		// TODO: should be stoped the monitor: when running as a pure CLI  is released
-		// by the OS, when run as daemon the resource's state is unknown and could be leaked.
-		sketch.Metadata.CPU = sketches.BoardMetadata{
-			Fqbn: board.FQBN(),
-			Name: board.Name(),
-		}
+		sketch.Metadata.AttachBoard(board.FQBN(), board.Name())
 	}
 
 	err = sketch.ExportMetadata()
@@ -109,52 +96,63 @@ func Attach(ctx context.Context, req *rpc.BoardAttachReq, taskCB commands.TaskPr
 	return &rpc.BoardAttachResp{}, nil
 }
 
-// FIXME: Those should probably go in a "BoardManager" pkg or something
-// findSerialConnectedBoard find the board which is connected to the specified URI via serial port, using a monitor and a set of Boards
-// for the matching.
-func findSerialConnectedBoard(pm *packagemanager.PackageManager, monitor *discovery.Monitor, deviceURI *url.URL) *cores.Board {
-	found := false
-	location := deviceURI.Path
-	var serialDevice discovery.SerialDevice
-	for _, device := range monitor.Serial() {
-		if device.Port == location {
-			// Found the device !
-			found = true
-			serialDevice = *device
-		}
-	}
-	if !found {
-		return nil
+// discoverPorts runs all the available discoveries for the given duration
+// and returns the ports that are connected at the end of the search
+func discoverPorts(ctx context.Context, pm *packagemanager.PackageManager, duration time.Duration) ([]*commands.BoardPort, error) {
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	events, err := commands.WatchListBoards(ctx, pm)
+	if err != nil {
+		return nil, err
 	}
 
-	boards := pm.FindBoardsWithVidPid(serialDevice.VendorID, serialDevice.ProductID)
-	if len(boards) == 0 {
-		return nil
+	ports := map[string]*commands.BoardPort{}
+	for event := range events {
+		switch event.Type {
+		case "add":
+			ports[event.Port.String()] = event.Port
+		case "remove":
+			delete(ports, event.Port.String())
+		}
 	}
 
-	return boards[0]
+	res := []*commands.BoardPort{}
+	for _, port := range ports {
+		res = append(res, port)
+	}
+	return res, nil
 }
 
-// findNetworkConnectedBoard find the board which is connected to the specified URI on the network, using a monitor and a set of Boards
-// for the matching.
-func findNetworkConnectedBoard(pm *packagemanager.PackageManager, monitor *discovery.Monitor, deviceURI *url.URL) *cores.Board {
-	found := false
-
-	var networkDevice discovery.NetworkDevice
-
-	for _, device := range monitor.Network() {
-		if device.Address == deviceURI.Host &&
-			fmt.Sprint(device.Port) == deviceURI.Port() {
-			// Found the device !
-			found = true
-			networkDevice = *device
+// FIXME: Those should probably go in a "BoardManager" pkg or something
+// findConnectedBoard find the board which is connected to the specified URI, using the ports
+// detected by the discoveries and the installed Boards for the matching.
+func findConnectedBoard(pm *packagemanager.PackageManager, ports []*commands.BoardPort, deviceURI *url.URL) *cores.Board {
+	var found *commands.BoardPort
+	for _, port := range ports {
+		switch deviceURI.Scheme {
+		case "serial", "tty":
+			if port.Protocol == "serial" && port.Address == deviceURI.Host+deviceURI.Path {
+				found = port
+			}
+		default:
+			host, _, err := net.SplitHostPort(port.Address)
+			if err != nil {
+				host = port.Address
+			}
+			if port.Protocol != "serial" && (port.Address == deviceURI.Host || host == deviceURI.Hostname()) {
+				found = port
+			}
 		}
 	}
-	if !found {
+	if found == nil {
 		return nil
 	}
 
-	boards := pm.FindBoardsWithID(networkDevice.Name)
+	boards := pm.IdentifyBoard(found.IdentificationPrefs)
+	if len(boards) == 0 {
+		boards = identifyNetworkBoard(pm, found)
+	}
 	if len(boards) == 0 {
 		return nil
 	}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/commands"
@@ -39,7 +40,7 @@ func Details(ctx context.Context, req *rpc.BoardDetailsReq) (*rpc.BoardDetailsRe
 		return nil, fmt.Errorf("parsing fqbn: %s", err)
 	}
 
-	_, _, board, _, _, err := pm.ResolveFQBN(fqbn)
+	_, boardPlatform, board, _, buildPlatform, err := pm.ResolveFQBN(fqbn)
 	if err != nil {
 		return nil, fmt.Errorf("loading board data: %s", err)
 	}
@@ -80,5 +81,25 @@ func Details(ctx context.Context, req *rpc.BoardDetailsReq) (*rpc.BoardDetailsRe
 		})
 	}
 
+	details.Programmers = []*rpc.Programmer{}
+	addProgrammers := func(platform *cores.PlatformRelease) {
+		ids := []string{}
+		for id := range platform.Programmers {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			details.Programmers = append(details.Programmers, &rpc.Programmer{
+				Platform: platform.Platform.String(),
+				Id:       id,
+				Name:     platform.Programmers[id].Get("name"),
+			})
+		}
+	}
+	addProgrammers(boardPlatform)
+	if buildPlatform != nil && buildPlatform != boardPlatform {
+		addProgrammers(buildPlatform)
+	}
+
 	return details, nil
 }
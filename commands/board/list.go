@@ -18,6 +18,7 @@
 package board
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -25,6 +26,8 @@ import (
 	"regexp"
 	"sync"
 
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/cli/globals"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
@@ -118,33 +121,9 @@ func List(instanceID int32) ([]*rpc.DetectedPort, error) {
 
 	retVal := []*rpc.DetectedPort{}
 	for _, port := range ports {
-		b := []*rpc.BoardListItem{}
-
-		// first query installed cores through the Package Manager
-		logrus.Debug("Querying installed cores for board identification...")
-		for _, board := range pm.IdentifyBoard(port.IdentificationPrefs) {
-			b = append(b, &rpc.BoardListItem{
-				Name: board.Name(),
-				FQBN: board.FQBN(),
-			})
-		}
-
-		// if installed cores didn't recognize the board, try querying
-		// the builder API if the board is a USB device port
-		if len(b) == 0 {
-			items, err := identifyViaCloudAPI(port)
-			if err == ErrNotFound {
-				// the board couldn't be detected, print a warning
-				logrus.Debug("Board not recognized")
-			} else if err != nil {
-				// this is bad, bail out
-				return nil, errors.Wrap(err, "error getting board info from Arduino Cloud")
-			}
-
-			// add a DetectedPort entry in any case: the `Boards` field will
-			// be empty but the port will be shown anyways (useful for 3rd party
-			// boards)
-			b = items
+		b, err := identify(pm, port)
+		if err != nil {
+			return nil, err
 		}
 
 		// boards slice can be empty at this point if neither the cores nor the
@@ -160,3 +139,110 @@ func List(instanceID int32) ([]*rpc.DetectedPort, error) {
 
 	return retVal, nil
 }
+
+// Watch starts watching the connected ports and returns a channel that
+// receives an event each time a board is connected or disconnected. The
+// channel is closed when the context is cancelled.
+func Watch(ctx context.Context, instanceID int32) (<-chan *rpc.BoardListWatchResp, error) {
+	pm := commands.GetPackageManager(instanceID)
+	if pm == nil {
+		return nil, errors.New("invalid instance")
+	}
+
+	events, err := commands.WatchListBoards(ctx, pm)
+	if err != nil {
+		return nil, errors.Wrap(err, "error starting serial-discovery")
+	}
+
+	outChan := make(chan *rpc.BoardListWatchResp)
+	go func() {
+		defer close(outChan)
+		for event := range events {
+			resp := &rpc.BoardListWatchResp{
+				EventType: event.Type,
+				Port: &rpc.DetectedPort{
+					Address:       event.Port.Address,
+					Protocol:      event.Port.Protocol,
+					ProtocolLabel: event.Port.ProtocolLabel,
+				},
+			}
+			if event.Type == "add" {
+				boards, err := identify(pm, event.Port)
+				if err != nil {
+					resp.Error = err.Error()
+				}
+				resp.Port.Boards = boards
+			}
+
+			select {
+			case outChan <- resp:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return outChan, nil
+}
+
+// identifyNetworkBoard returns the installed boards matching the board ID
+// advertised by a network port in its mDNS TXT records
+func identifyNetworkBoard(pm *packagemanager.PackageManager, port *commands.BoardPort) []*cores.Board {
+	boards := []*cores.Board{}
+	if port.Protocol != "network" || port.IdentificationPrefs == nil {
+		return boards
+	}
+	boardID := port.IdentificationPrefs.Get("board")
+	if boardID == "" {
+		return boards
+	}
+	for _, board := range pm.InstalledBoards() {
+		if board.BoardID == boardID {
+			boards = append(boards, board)
+		}
+	}
+	return boards
+}
+
+// identify returns the boards that may be connected to the given port
+func identify(pm *packagemanager.PackageManager, port *commands.BoardPort) ([]*rpc.BoardListItem, error) {
+	boards := []*rpc.BoardListItem{}
+
+	// first query installed cores through the Package Manager
+	logrus.Debug("Querying installed cores for board identification...")
+	for _, board := range pm.IdentifyBoard(port.IdentificationPrefs) {
+		boards = append(boards, &rpc.BoardListItem{
+			Name: board.Name(),
+			FQBN: board.FQBN(),
+		})
+	}
+
+	if len(boards) == 0 {
+		for _, board := range identifyNetworkBoard(pm, port) {
+			boards = append(boards, &rpc.BoardListItem{
+				Name: board.Name(),
+				FQBN: board.FQBN(),
+			})
+		}
+	}
+
+	// if installed cores didn't recognize the board, try querying
+	// the builder API if the board is a USB device port
+	if len(boards) == 0 {
+		items, err := identifyViaCloudAPI(port)
+		if err == ErrNotFound {
+			// the board couldn't be detected, print a warning
+			logrus.Debug("Board not recognized")
+		} else if err != nil {
+			// this is bad, bail out
+			return nil, errors.Wrap(err, "error getting board info from Arduino Cloud")
+		}
+
+		// add a DetectedPort entry in any case: the `Boards` field will
+		// be empty but the port will be shown anyways (useful for 3rd party
+		// boards)
+		boards = items
+	}
+
+	return boards, nil
+}
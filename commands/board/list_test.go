@@ -16,6 +16,7 @@
 package board
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -102,3 +103,12 @@ func TestBoardDetectionViaAPIWithNonUSBPort(t *testing.T) {
 	require.Equal(t, err, ErrNotFound)
 	require.Empty(t, items)
 }
+
+func TestBoardDetectionWithoutPrefs(t *testing.T) {
+	// the prefs are optional in the ports sent by the discoveries
+	port := &commands.BoardPort{}
+	require.NoError(t, json.Unmarshal([]byte(`{ "address": "/dev/ttyACM0", "protocol": "serial" }`), port))
+	items, err := identifyViaCloudAPI(port)
+	require.Equal(t, err, ErrNotFound)
+	require.Empty(t, items)
+}
@@ -0,0 +1,209 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to modify or
+// otherwise use the software for commercial activities involving the Arduino
+// software without disclosing the source code of your own applications. To purchase
+// a commercial license, send an email to license@arduino.cc.
+package compile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	bldr "github.com/arduino/arduino-cli/arduino/builder"
+	"github.com/arduino/arduino-cli/configs"
+	"github.com/arduino/arduino-cli/legacy/builder"
+	"github.com/arduino/arduino-cli/legacy/builder/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// CodeComplete computes the code completions at the given position of a
+// sketch file. The sketch goes through the same include detection and
+// preprocessing steps of a build and the completions are computed by
+// arduino-preprocessor on the resulting merged source.
+func CodeComplete(ctx context.Context, req *rpc.CodeCompleteReq, config *configs.Configuration, debug bool) (*rpc.CodeCompleteResp, error) {
+	if req.GetFile() == "" {
+		return nil, errors.New("missing file")
+	}
+	if req.GetLine() < 1 || req.GetColumn() < 1 {
+		return nil, fmt.Errorf("invalid position %d:%d", req.GetLine(), req.GetColumn())
+	}
+
+	builderCtx, sketch, err := newBuilderContext(&rpc.CompileReq{
+		Instance:        req.GetInstance(),
+		Fqbn:            req.GetFqbn(),
+		SketchPath:      req.GetSketchPath(),
+		BuildPath:       req.GetBuildPath(),
+		BuildProperties: req.GetBuildProperties(),
+	}, config, debug)
+	if err != nil {
+		return nil, err
+	}
+
+	sketchDir := sketch.FullPath
+	if !sketchDir.IsDir() {
+		sketchDir = sketchDir.Parent()
+	}
+	file := paths.New(req.GetFile())
+	if !file.IsAbs() {
+		file = sketchDir.Join(req.GetFile())
+	}
+	if inside, err := file.IsInsideDir(sketchDir); err != nil || !inside {
+		return nil, fmt.Errorf("file %s is not part of the sketch", file)
+	}
+
+	// The completions are computed on a dedicated build path to not mess
+	// with the files of a regular build of the same sketch
+	if builderCtx.BuildPath == nil {
+		builderCtx.BuildPath = paths.New(bldr.GenBuildPath(sketch.FullPath).String() + "-codecomplete")
+	}
+	// Editors send overlapping requests: the requests on the same build
+	// path are serialized, otherwise they would overwrite each other's files
+	unlock := lockCodeCompleteBuildPath(builderCtx.BuildPath)
+	defer unlock()
+	if err := builderCtx.BuildPath.MkdirAll(); err != nil {
+		return nil, fmt.Errorf("cannot create build directory: %s", err)
+	}
+
+	// Unsaved contents are handled by preprocessing a copy of the sketch
+	if req.GetUnsavedContent() != "" {
+		tmpDir, err := paths.MkTempDir("", "arduino-codecomplete-")
+		if err != nil {
+			return nil, fmt.Errorf("creating temp dir: %s", err)
+		}
+		defer tmpDir.RemoveAll()
+
+		sketchCopy := tmpDir.Join(sketchDir.Base())
+		if err := sketchDir.CopyDirTo(sketchCopy); err != nil {
+			return nil, fmt.Errorf("copying sketch: %s", err)
+		}
+		relFile, err := sketchDir.RelTo(file)
+		if err != nil {
+			return nil, fmt.Errorf("copying sketch: %s", err)
+		}
+		file = sketchCopy.Join(relFile.String())
+		if err := file.WriteFile([]byte(req.GetUnsavedContent())); err != nil {
+			return nil, fmt.Errorf("writing unsaved content: %s", err)
+		}
+		if sketch.FullPath.IsDir() {
+			builderCtx.SketchLocation = sketchCopy
+		} else {
+			builderCtx.SketchLocation = sketchCopy.Join(sketch.FullPath.Base())
+		}
+	}
+
+	builderCtx.CodeCompleteAt = fmt.Sprintf("%s:%d:%d", file, req.GetLine(), req.GetColumn())
+	builderCtx.SetLogger(i18n.NoopLogger{})
+
+	logrus.Tracef("Code completion at %s", builderCtx.CodeCompleteAt)
+	if err := builder.RunCodeComplete(builderCtx); err != nil {
+		return nil, fmt.Errorf("computing completions: %s", err)
+	}
+
+	items, err := parseCodeCompletions(builderCtx.CodeCompletions)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CodeCompleteResp{Items: items}, nil
+}
+
+var codeCompleteLocks = map[string]*sync.Mutex{}
+var codeCompleteLocksMux sync.Mutex
+
+// lockCodeCompleteBuildPath locks the given build path for a code
+// completion request and returns the function to unlock it
+func lockCodeCompleteBuildPath(buildPath *paths.Path) func() {
+	codeCompleteLocksMux.Lock()
+	lock, ok := codeCompleteLocks[buildPath.String()]
+	if !ok {
+		lock = &sync.Mutex{}
+		codeCompleteLocks[buildPath.String()] = lock
+	}
+	codeCompleteLocksMux.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+// codeCompletionJSON is a completion item as produced by arduino-preprocessor
+type codeCompletionJSON struct {
+	TypedText    string `json:"typedText"`
+	ResultType   string `json:"resultType"`
+	Priority     int32  `json:"priority"`
+	Availability string `json:"availability"`
+	Completion   struct {
+		Chunks []map[string]json.RawMessage `json:"chunks"`
+		Brief  string                       `json:"brief"`
+	} `json:"completion"`
+}
+
+// parseCodeCompletions converts the JSON output of arduino-preprocessor in
+// a list of completion items. Every chunk of a completion string is an
+// object with a single field, named after the kind of the chunk.
+func parseCodeCompletions(data string) ([]*rpc.CompletionItem, error) {
+	data = strings.TrimSpace(data)
+	if data == "" {
+		return []*rpc.CompletionItem{}, nil
+	}
+	var completions []*codeCompletionJSON
+	if err := json.Unmarshal([]byte(data), &completions); err != nil {
+		return nil, fmt.Errorf("parsing completions: %s", err)
+	}
+
+	res := []*rpc.CompletionItem{}
+	for _, completion := range completions {
+		item := &rpc.CompletionItem{
+			Label:         completion.TypedText,
+			ResultType:    completion.ResultType,
+			Documentation: completion.Completion.Brief,
+			Priority:      completion.Priority,
+			Availability:  completion.Availability,
+		}
+		detail := ""
+		for _, chunk := range completion.Completion.Chunks {
+			for kind, value := range chunk {
+				var text string
+				if json.Unmarshal(value, &text) != nil {
+					// optional chunks contain nested completion
+					// strings, they are not part of the signature
+					continue
+				}
+				switch kind {
+				case "typedtext":
+					if item.Label == "" {
+						item.Label = text
+					}
+					detail += text
+				case "placeholder":
+					item.Parameters = append(item.Parameters, text)
+					detail += text
+				case "res":
+					if item.ResultType == "" {
+						item.ResultType = text
+					}
+				case "info":
+					// informative chunks are not part of the signature
+				default:
+					detail += text
+				}
+			}
+		}
+		item.Detail = detail
+		res = append(res, item)
+	}
+	return res, nil
+}
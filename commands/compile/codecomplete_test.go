@@ -0,0 +1,81 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+package compile
+
+import (
+	"testing"
+	"time"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCodeCompletions(t *testing.T) {
+	items, err := parseCodeCompletions("")
+	require.NoError(t, err)
+	require.Empty(t, items)
+
+	items, err = parseCodeCompletions(`[
+	{"typedText":"digitalWrite","resultType":"void","priority":50,"availability":"Available",
+	 "completion":{"chunks":[{"res":"void"},{"typedtext":"digitalWrite"},{"leftparen":"("},{"placeholder":"uint8_t pin"},{"comma":", "},{"placeholder":"uint8_t val"},{"rightparen":")"}],"brief":"Write a digital pin"}},
+	{"typedText":"delay","priority":50,"availability":"Available",
+	 "completion":{"chunks":[{"res":"void"},{"typedtext":"delay"},{"leftparen":"("},{"placeholder":"unsigned long ms"},{"optional":{"chunks":[{"placeholder":"int x"}]}},{"rightparen":")"}]}},
+	{"typedText":"LED_BUILTIN","resultType":"","priority":70,"availability":"Available",
+	 "completion":{"chunks":[{"typedtext":"LED_BUILTIN"}]}}
+	]`)
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	require.Equal(t, "digitalWrite", items[0].GetLabel())
+	require.Equal(t, "digitalWrite(uint8_t pin, uint8_t val)", items[0].GetDetail())
+	require.Equal(t, "void", items[0].GetResultType())
+	require.Equal(t, []string{"uint8_t pin", "uint8_t val"}, items[0].GetParameters())
+	require.Equal(t, "Write a digital pin", items[0].GetDocumentation())
+	require.Equal(t, int32(50), items[0].GetPriority())
+	require.Equal(t, "Available", items[0].GetAvailability())
+
+	require.Equal(t, "delay(unsigned long ms)", items[1].GetDetail())
+	require.Equal(t, "void", items[1].GetResultType())
+	require.Equal(t, []string{"unsigned long ms"}, items[1].GetParameters())
+
+	require.Equal(t, "LED_BUILTIN", items[2].GetDetail())
+	require.Empty(t, items[2].GetParameters())
+
+	_, err = parseCodeCompletions("not json")
+	require.Error(t, err)
+}
+
+func TestLockCodeCompleteBuildPath(t *testing.T) {
+	unlock := lockCodeCompleteBuildPath(paths.New("build-a"))
+
+	// another build path is not locked
+	lockCodeCompleteBuildPath(paths.New("build-b"))()
+
+	locked := make(chan bool)
+	go func() {
+		unlockAgain := lockCodeCompleteBuildPath(paths.New("build-a"))
+		locked <- true
+		unlockAgain()
+	}()
+	select {
+	case <-locked:
+		require.FailNow(t, "build path locked twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+	unlock()
+	<-locked
+}
@@ -26,10 +26,12 @@ import (
 	"sort"
 	"strings"
 
+	bldr "github.com/arduino/arduino-cli/arduino/builder"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/arduino/sketches"
 	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/upload"
 	"github.com/arduino/arduino-cli/configs"
 	"github.com/arduino/arduino-cli/legacy/builder"
 	"github.com/arduino/arduino-cli/legacy/builder/i18n"
@@ -40,33 +42,132 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// DiagnosticsCB is a callback to receive the diagnostics emitted by the
+// compiler, it's called once when the build terminates
+type DiagnosticsCB func(diagnostics []*rpc.CompileDiagnostic)
+
 // Compile FIXMEDOC
-func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.Writer, config *configs.Configuration, debug bool) (*rpc.CompileResp, error) {
+func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.Writer, taskCB commands.TaskProgressCB, diagnosticsCB DiagnosticsCB, config *configs.Configuration, debug bool) (*rpc.CompileResp, error) {
+	if len(req.GetFqbns()) > 0 {
+		return compileMultipleBoards(ctx, req, outStream, errStream, taskCB, diagnosticsCB, config, debug)
+	}
+
+	builderCtx, sketch, err := newBuilderContext(req, config, debug)
+	if err != nil {
+		return nil, err
+	}
+	fqbn := builderCtx.FQBN
+	fqbnIn := fqbn.String()
+
+	builderCtx.OnlyUpdateCompilationDatabase = req.GetCreateCompilationDatabaseOnly()
+	if builderCtx.OnlyUpdateCompilationDatabase && req.GetUpload() {
+		return nil, errors.New("cannot upload when only creating the compilation database")
+	}
+
+	builderCtx.ExecStdout = outStream
+	builderCtx.ExecStderr = errStream
+	builderCtx.SetLogger(i18n.LoggerToCustomStreams{Stdout: outStream, Stderr: errStream})
+
+	// if --preprocess or --show-properties were passed, we can stop here
+	if req.GetShowProperties() {
+		return &rpc.CompileResp{}, builder.RunParseHardwareAndDumpBuildProperties(builderCtx)
+	} else if req.GetPreprocess() {
+		return &rpc.CompileResp{}, builder.RunPreprocess(builderCtx)
+	}
+
+	// if it's a regular build, go on...
+	taskCB(&rpc.TaskProgress{Name: "Compiling " + sketch.Name + " for " + fqbnIn})
+	err = builder.RunBuilder(builderCtx)
+	diagnosticsCB(compileDiagnostics(builderCtx.CompilerDiagnostics))
+	if builderCtx.ObjectCache != nil {
+		if err := builderCtx.ObjectCache.Trim(); err != nil {
+			logrus.WithError(err).Warn("trimming object cache")
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("build failed: %s", err)
+	}
+	taskCB(&rpc.TaskProgress{Message: "Compilation completed", Completed: true})
+
+	if builderCtx.OnlyUpdateCompilationDatabase {
+		return &rpc.CompileResp{Result: &rpc.CompileResult{
+			BuildPath: builderCtx.BuildPath.String(),
+			Artifacts: []string{builderCtx.CompilationDatabase.File.String()},
+		}}, nil
+	}
+
+	// FIXME: Make a function to obtain these info...
+	outputPath := paths.New(
+		builderCtx.BuildProperties.ExpandPropsInString("{build.path}/{recipe.output.tmp_file}")) // "/build/path/sketch.ino.bin"
+
+	// When uploading, the binaries are taken directly from the build path
+	// and are exported only if explicitly requested
+	if !req.GetUpload() || req.GetExportFile() != "" {
+		if err := exportBinaries(sketch, fqbn, outputPath, req.GetExportFile()); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.GetUpload() {
+		taskCB(&rpc.TaskProgress{Name: "Uploading to " + req.GetPort()})
+		_, err := upload.Upload(ctx, &rpc.UploadReq{
+			Instance:   req.GetInstance(),
+			Fqbn:       fqbnIn,
+			Port:       req.GetPort(),
+			Verbose:    req.GetVerbose(),
+			Verify:     req.GetVerify(),
+			Programmer: req.GetProgrammer(),
+			ImportFile: outputPath.String(),
+		}, outStream, errStream, func(*rpc.UploadProgress) {})
+		if err == upload.ErrUploadCancelled || err == upload.ErrUploadTimeout {
+			return nil, err
+		} else if err != nil {
+			return nil, fmt.Errorf("upload failed: %s", err)
+		}
+		taskCB(&rpc.TaskProgress{Message: "Upload completed", Completed: true})
+	}
+
+	logrus.Tracef("Compile %s for %s successful", sketch.Name, fqbnIn)
+
+	return &rpc.CompileResp{Result: compileResult(builderCtx, outputPath)}, nil
+}
+
+// newBuilderContext prepares the builder context for the sketch and the board
+// specified in the request
+func newBuilderContext(req *rpc.CompileReq, config *configs.Configuration, debug bool) (*types.Context, *sketches.Sketch, error) {
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
 	if pm == nil {
-		return nil, errors.New("invalid instance")
+		return nil, nil, errors.New("invalid instance")
 	}
 
 	logrus.Tracef("Compile %s for %s started", req.GetSketchPath(), req.GetFqbn())
 	if req.GetSketchPath() == "" {
-		return nil, fmt.Errorf("missing sketchPath")
+		return nil, nil, fmt.Errorf("missing sketchPath")
 	}
 	sketchPath := paths.New(req.GetSketchPath())
 	sketch, err := sketches.NewSketchFromPath(sketchPath)
 	if err != nil {
-		return nil, fmt.Errorf("opening sketch: %s", err)
+		return nil, nil, fmt.Errorf("opening sketch: %s", err)
+	}
+
+	profile, err := sketch.Metadata.Profile(req.GetProfile())
+	if err != nil {
+		return nil, nil, err
 	}
 
 	fqbnIn := req.GetFqbn()
-	if fqbnIn == "" && sketch != nil && sketch.Metadata != nil {
+	if fqbnIn == "" && profile != nil {
+		fqbnIn = profile.Fqbn
+	}
+	if fqbnIn == "" && sketch.Metadata != nil {
 		fqbnIn = sketch.Metadata.CPU.Fqbn
 	}
 	if fqbnIn == "" {
-		return nil, fmt.Errorf("no FQBN provided")
+		return nil, nil, fmt.Errorf("no FQBN provided")
 	}
 	fqbn, err := cores.ParseFQBN(fqbnIn)
 	if err != nil {
-		return nil, fmt.Errorf("incorrect FQBN: %s", err)
+		return nil, nil, fmt.Errorf("incorrect FQBN: %s", err)
 	}
 
 	targetPlatform := pm.FindPlatform(&packagemanager.PlatformReference{
@@ -79,7 +180,14 @@ func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.W
 		// 	"\"%[1]s:%[2]s\" platform is not installed, please install it by running \""+
 		// 		version.GetAppName()+" core install %[1]s:%[2]s\".", fqbn.Package, fqbn.PlatformArch)
 		// feedback.Error(errorMessage)
-		return nil, fmt.Errorf("platform not installed")
+		return nil, nil, fmt.Errorf("platform not installed")
+	}
+
+	if profile != nil {
+		lm := commands.GetLibraryManager(req.GetInstance().GetId())
+		if err := checkProfileRequirements(pm, lm, profile); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	builderCtx := &types.Context{}
@@ -91,13 +199,13 @@ func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.W
 	if packagesDir, err := config.HardwareDirectories(); err == nil {
 		builderCtx.HardwareDirs = packagesDir
 	} else {
-		return nil, fmt.Errorf("cannot get hardware directories: %s", err)
+		return nil, nil, fmt.Errorf("cannot get hardware directories: %s", err)
 	}
 
 	if toolsDir, err := config.BundleToolsDirectories(); err == nil {
 		builderCtx.BuiltInToolsDirs = toolsDir
 	} else {
-		return nil, fmt.Errorf("cannot get bundled tools directories: %s", err)
+		return nil, nil, fmt.Errorf("cannot get bundled tools directories: %s", err)
 	}
 
 	builderCtx.OtherLibrariesDirs = paths.NewPathList()
@@ -107,18 +215,27 @@ func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.W
 		builderCtx.BuildPath = paths.New(req.GetBuildPath())
 		err = builderCtx.BuildPath.MkdirAll()
 		if err != nil {
-			return nil, fmt.Errorf("cannot create build directory: %s", err)
+			return nil, nil, fmt.Errorf("cannot create build directory: %s", err)
 		}
 	}
 
 	builderCtx.Verbose = req.GetVerbose()
 
 	builderCtx.CoreBuildCachePath = paths.TempDir().Join("arduino-core-cache")
+	if !config.BuildCacheDisabled {
+		builderCtx.ObjectCache = bldr.NewObjectCache(config.BuildCacheDir(), config.BuildCacheMaxSize)
+	}
 
 	builderCtx.Jobs = int(req.GetJobs())
 
 	builderCtx.USBVidPid = req.GetVidPid()
 	builderCtx.WarningsLevel = req.GetWarnings()
+	if builderCtx.WarningsLevel == "" && profile != nil {
+		builderCtx.WarningsLevel = profile.Warnings
+	}
+	if builderCtx.WarningsLevel == "" {
+		builderCtx.WarningsLevel = builder.DEFAULT_WARNINGS_LEVEL
+	}
 
 	if debug {
 		builderCtx.DebugLevel = 100
@@ -126,13 +243,19 @@ func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.W
 		builderCtx.DebugLevel = 5
 	}
 
-	builderCtx.CustomBuildProperties = append(req.GetBuildProperties(), "build.warn_data_percentage=75")
+	// the build properties of the request take precedence over the profile ones
+	builderCtx.CustomBuildProperties = []string{}
+	if profile != nil {
+		builderCtx.CustomBuildProperties = append(builderCtx.CustomBuildProperties, profile.BuildProperties...)
+	}
+	builderCtx.CustomBuildProperties = append(builderCtx.CustomBuildProperties, req.GetBuildProperties()...)
+	builderCtx.CustomBuildProperties = append(builderCtx.CustomBuildProperties, "build.warn_data_percentage=75")
 
 	if req.GetBuildCachePath() != "" {
 		builderCtx.BuildCachePath = paths.New(req.GetBuildCachePath())
 		err = builderCtx.BuildCachePath.MkdirAll()
 		if err != nil {
-			return nil, fmt.Errorf("cannot create build cache directory: %s", err)
+			return nil, nil, fmt.Errorf("cannot create build cache directory: %s", err)
 		}
 	}
 
@@ -157,25 +280,12 @@ func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.W
 		builderCtx.BuiltInLibrariesDirs = paths.NewPathList(ideLibrariesPath)
 	}
 
-	builderCtx.ExecStdout = outStream
-	builderCtx.ExecStderr = errStream
-	builderCtx.SetLogger(i18n.LoggerToCustomStreams{Stdout: outStream, Stderr: errStream})
-
-	// if --preprocess or --show-properties were passed, we can stop here
-	if req.GetShowProperties() {
-		return &rpc.CompileResp{}, builder.RunParseHardwareAndDumpBuildProperties(builderCtx)
-	} else if req.GetPreprocess() {
-		return &rpc.CompileResp{}, builder.RunPreprocess(builderCtx)
-	}
-
-	// if it's a regular build, go on...
-	if err := builder.RunBuilder(builderCtx); err != nil {
-		return nil, fmt.Errorf("build failed: %s", err)
-	}
+	return builderCtx, sketch, nil
+}
 
-	// FIXME: Make a function to obtain these info...
-	outputPath := paths.New(
-		builderCtx.BuildProperties.ExpandPropsInString("{build.path}/{recipe.output.tmp_file}")) // "/build/path/sketch.ino.bin"
+// exportBinaries copies the binaries produced by the build in the sketch
+// folder, or in the folder of exportFilePath if specified
+func exportBinaries(sketch *sketches.Sketch, fqbn *cores.FQBN, outputPath *paths.Path, exportFilePath string) error {
 	ext := outputPath.Ext()          // ".hex" | ".bin"
 	base := outputPath.Base()        // "sketch.ino.hex"
 	base = base[:len(base)-len(ext)] // "sketch.ino"
@@ -187,7 +297,7 @@ func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.W
 
 	var exportPath *paths.Path
 	var exportFile string
-	if req.GetExportFile() == "" {
+	if exportFilePath == "" {
 		if sketch.FullPath.IsDir() {
 			exportPath = sketch.FullPath
 		} else {
@@ -195,8 +305,8 @@ func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.W
 		}
 		exportFile = sketch.Name + "." + fqbnSuffix // "sketch.arduino.avr.uno"
 	} else {
-		exportPath = paths.New(req.GetExportFile()).Parent()
-		exportFile = paths.New(req.GetExportFile()).Base()
+		exportPath = paths.New(exportFilePath).Parent()
+		exportFile = paths.New(exportFilePath).Base()
 		if strings.HasSuffix(exportFile, ext) {
 			exportFile = exportFile[:len(exportFile)-len(ext)]
 		}
@@ -205,7 +315,7 @@ func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.W
 	// Copy "sketch.ino.*.hex" / "sketch.ino.*.bin" artifacts to sketch directory
 	srcDir, err := outputPath.Parent().ReadDir() // read "/build/path/*"
 	if err != nil {
-		return nil, fmt.Errorf("reading build directory: %s", err)
+		return fmt.Errorf("reading build directory: %s", err)
 	}
 	srcDir.FilterPrefix(base + ".")
 	srcDir.FilterSuffix(ext)
@@ -215,7 +325,7 @@ func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.W
 		dstOutput := exportPath.Join(exportFile + srcFilename)
 		logrus.WithField("from", srcOutput).WithField("to", dstOutput).Debug("copying sketch build output")
 		if err = srcOutput.CopyTo(dstOutput); err != nil {
-			return nil, fmt.Errorf("copying output file: %s", err)
+			return fmt.Errorf("copying output file: %s", err)
 		}
 	}
 
@@ -225,11 +335,9 @@ func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.W
 		dstElf := exportPath.Join(exportFile + ".elf")
 		logrus.WithField("from", srcElf).WithField("to", dstElf).Debug("copying sketch build output")
 		if err = srcElf.CopyTo(dstElf); err != nil {
-			return nil, fmt.Errorf("copying elf file: %s", err)
+			return fmt.Errorf("copying elf file: %s", err)
 		}
 	}
 
-	logrus.Tracef("Compile %s for %s successful", sketch.Name, fqbnIn)
-
-	return &rpc.CompileResp{}, nil
+	return nil
 }
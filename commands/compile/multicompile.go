@@ -0,0 +1,149 @@
+//
+// This file is part of arduino-cli.
+//
+// Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to modify or
+// otherwise use the software for commercial activities involving the Arduino
+// software without disclosing the source code of your own applications. To purchase
+// a commercial license, send an email to license@arduino.cc.
+//
+
+package compile
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	bldr "github.com/arduino/arduino-cli/arduino/builder"
+	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configs"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/golang/protobuf/proto"
+	"github.com/sirupsen/logrus"
+)
+
+// compileMultipleBoards compiles the sketch for all the boards in req.Fqbns,
+// concurrently. Each board is built in a separate build path and the lines
+// of its output are prefixed with the FQBN. The response contains the
+// results of all the boards, if any of them failed an error is returned too.
+func compileMultipleBoards(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.Writer, taskCB commands.TaskProgressCB, diagnosticsCB DiagnosticsCB, config *configs.Configuration, debug bool) (*rpc.CompileResp, error) {
+	fqbns := req.GetFqbns()
+	seen := map[string]bool{}
+	for _, fqbn := range fqbns {
+		if seen[fqbn] {
+			return nil, fmt.Errorf("board %s specified more than once", fqbn)
+		}
+		seen[fqbn] = true
+	}
+	if req.GetUpload() {
+		return nil, fmt.Errorf("cannot upload when compiling for several boards")
+	}
+	if req.GetExportFile() != "" {
+		return nil, fmt.Errorf("cannot export to a single file when compiling for several boards")
+	}
+	if req.GetShowProperties() || req.GetPreprocess() {
+		return nil, fmt.Errorf("cannot show properties or preprocess when compiling for several boards")
+	}
+
+	var mux sync.Mutex
+	syncTaskCB := func(progress *rpc.TaskProgress) {
+		mux.Lock()
+		defer mux.Unlock()
+		taskCB(progress)
+	}
+
+	results := make([]*rpc.BoardCompileResult, len(fqbns))
+	diagnostics := make([][]*rpc.CompileDiagnostic, len(fqbns))
+	var wg sync.WaitGroup
+	for i, fqbn := range fqbns {
+		wg.Add(1)
+		go func(i int, fqbn string) {
+			defer wg.Done()
+			boardReq := proto.Clone(req).(*rpc.CompileReq)
+			boardReq.Fqbn = fqbn
+			boardReq.Fqbns = nil
+			boardReq.BuildPath = boardBuildPath(req, fqbn)
+
+			prefix := "[" + fqbn + "] "
+			out := utils.NewLinePrefixer(outStream, prefix, &mux)
+			errOut := utils.NewLinePrefixer(errStream, prefix, &mux)
+			resp, err := Compile(ctx, boardReq, out, errOut, syncTaskCB,
+				func(d []*rpc.CompileDiagnostic) { diagnostics[i] = d },
+				config, debug)
+			out.Flush()
+			errOut.Flush()
+			if err != nil {
+				logrus.WithError(err).WithField("fqbn", fqbn).Error("Compilation failed")
+			}
+			results[i] = boardCompileResult(fqbn, resp, err, diagnostics[i])
+		}(i, fqbn)
+	}
+	wg.Wait()
+
+	allDiagnostics := []*rpc.CompileDiagnostic{}
+	for _, d := range diagnostics {
+		allDiagnostics = append(allDiagnostics, d...)
+	}
+	diagnosticsCB(allDiagnostics)
+
+	resp := &rpc.CompileResp{BoardResults: results}
+	failed := 0
+	for _, result := range results {
+		if !result.GetSuccess() {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return resp, fmt.Errorf("compilation failed for %d of %d boards", failed, len(fqbns))
+	}
+	return resp, nil
+}
+
+// boardBuildPath returns the build path for the given board, a subfolder of
+// the requested build path or a dedicated temporary folder
+func boardBuildPath(req *rpc.CompileReq, fqbn string) string {
+	dirName := strings.Replace(fqbn, ":", ".", -1)
+	if req.GetBuildPath() != "" {
+		return paths.New(req.GetBuildPath()).Join(dirName).String()
+	}
+	if req.GetSketchPath() == "" {
+		return ""
+	}
+	return bldr.GenBuildPath(paths.New(req.GetSketchPath())).String() + "-" + dirName
+}
+
+// boardCompileResult summarizes the compilation for a board, the warnings
+// and errors are counted on the diagnostics of all the compiled files: the
+// output of the files up to date or restored from the object cache is
+// replayed by the builder
+func boardCompileResult(fqbn string, resp *rpc.CompileResp, err error, diagnostics []*rpc.CompileDiagnostic) *rpc.BoardCompileResult {
+	res := &rpc.BoardCompileResult{
+		Fqbn:    fqbn,
+		Success: err == nil,
+		Result:  resp.GetResult(),
+	}
+	if err != nil {
+		res.Error = err.Error()
+	}
+	for _, diagnostic := range diagnostics {
+		switch diagnostic.GetSeverity() {
+		case "warning":
+			res.Warnings++
+		case "error":
+			res.Errors++
+		}
+	}
+	return res
+}
@@ -0,0 +1,65 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+package compile
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileMultipleBoardsValidation(t *testing.T) {
+	compile := func(req *rpc.CompileReq) error {
+		_, err := Compile(context.Background(), req, nil, nil, func(*rpc.TaskProgress) {}, func([]*rpc.CompileDiagnostic) {}, nil, false)
+		return err
+	}
+	require.Error(t, compile(&rpc.CompileReq{Fqbns: []string{"arduino:avr:uno", "arduino:avr:uno"}}))
+	require.Error(t, compile(&rpc.CompileReq{Fqbns: []string{"arduino:avr:uno", "arduino:samd:mkr1000"}, Upload: true}))
+	require.Error(t, compile(&rpc.CompileReq{Fqbns: []string{"arduino:avr:uno", "arduino:samd:mkr1000"}, ExportFile: "out.hex"}))
+}
+
+func TestBoardBuildPath(t *testing.T) {
+	req := &rpc.CompileReq{SketchPath: "/sketch", BuildPath: "/build"}
+	require.Equal(t, "/build/arduino.avr.uno", boardBuildPath(req, "arduino:avr:uno"))
+
+	req = &rpc.CompileReq{SketchPath: "/sketch"}
+	uno := boardBuildPath(req, "arduino:avr:uno")
+	mkr := boardBuildPath(req, "arduino:samd:mkr1000")
+	require.True(t, strings.HasSuffix(uno, "-arduino.avr.uno"))
+	require.NotEqual(t, uno, mkr)
+}
+
+func TestBoardCompileResult(t *testing.T) {
+	diagnostics := []*rpc.CompileDiagnostic{
+		{Severity: "warning"}, {Severity: "warning"}, {Severity: "error"}, {Severity: "note"},
+	}
+	res := boardCompileResult("arduino:avr:uno", nil, errors.New("build failed"), diagnostics)
+	require.Equal(t, "arduino:avr:uno", res.GetFqbn())
+	require.False(t, res.GetSuccess())
+	require.Equal(t, "build failed", res.GetError())
+	require.Equal(t, int32(2), res.GetWarnings())
+	require.Equal(t, int32(1), res.GetErrors())
+
+	result := &rpc.CompileResult{BuildPath: "/build"}
+	res = boardCompileResult("arduino:avr:uno", &rpc.CompileResp{Result: result}, nil, nil)
+	require.True(t, res.GetSuccess())
+	require.Equal(t, result, res.GetResult())
+}
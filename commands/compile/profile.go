@@ -0,0 +1,85 @@
+//
+// This file is part of arduino-cli.
+//
+// Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to modify or
+// otherwise use the software for commercial activities involving the Arduino
+// software without disclosing the source code of your own applications. To purchase
+// a commercial license, send an email to license@arduino.cc.
+//
+
+package compile
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/sketches"
+)
+
+// checkProfileRequirements verifies that the versions of the platforms and
+// of the libraries required by the build profile are installed
+func checkProfileRequirements(pm *packagemanager.PackageManager, lm *librariesmanager.LibrariesManager, profile *sketches.BuildProfile) error {
+	for _, id := range sortedKeys(profile.Platforms) {
+		required := profile.Platforms[id]
+		split := strings.Split(id, ":")
+		if len(split) != 2 {
+			return fmt.Errorf("invalid platform %s in build profile", id)
+		}
+		platform := pm.FindPlatform(&packagemanager.PlatformReference{
+			Package:              split[0],
+			PlatformArchitecture: split[1],
+		})
+		if platform == nil || pm.GetInstalledPlatformRelease(platform) == nil {
+			return fmt.Errorf("platform %s@%s required by the build profile is not installed", id, required)
+		}
+		release := pm.GetInstalledPlatformRelease(platform)
+		if release.Version.String() != required {
+			return fmt.Errorf("platform %s@%s required by the build profile, but %s is installed", id, required, release.Version)
+		}
+	}
+
+	for _, name := range sortedKeys(profile.Libraries) {
+		required := profile.Libraries[name]
+		installed := []string{}
+		found := false
+		if lm != nil {
+			for _, alternatives := range lm.Libraries {
+				for _, lib := range alternatives.Alternatives {
+					if lib.Name != name && lib.RealName != name {
+						continue
+					}
+					installed = append(installed, lib.Version.String())
+					if lib.Version.String() == required {
+						found = true
+					}
+				}
+			}
+		}
+		if !found && len(installed) == 0 {
+			return fmt.Errorf("library %s@%s required by the build profile is not installed", name, required)
+		} else if !found {
+			return fmt.Errorf("library %s@%s required by the build profile, but %s is installed", name, required, strings.Join(installed, ", "))
+		}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := []string{}
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
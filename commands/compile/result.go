@@ -0,0 +1,150 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package compile
+
+import (
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/builder"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+)
+
+// resultPropertiesPrefixes are the prefixes of the build properties
+// returned in the CompileResult
+var resultPropertiesPrefixes = []string{
+	"build.",
+	"runtime.",
+	"compiler.path",
+	"recipe.output.",
+	"upload.maximum_size",
+	"upload.maximum_data_size",
+}
+
+// compileResult collects the information about a successful build
+func compileResult(ctx *types.Context, outputPath *paths.Path) *rpc.CompileResult {
+	res := &rpc.CompileResult{
+		BuildPath:       ctx.BuildPath.String(),
+		Artifacts:       buildArtifacts(outputPath),
+		BuildProperties: resultProperties(ctx.BuildProperties),
+		BoardPlatform:   platformReference(ctx.TargetPlatform),
+	}
+	if ctx.ActualPlatform != ctx.TargetPlatform {
+		res.BuildPlatform = platformReference(ctx.ActualPlatform)
+	}
+
+	for _, lib := range ctx.ImportedLibraries {
+		usedLib := &rpc.UsedLibrary{
+			Name:     lib.Name,
+			Location: lib.Location.String(),
+		}
+		if lib.Version != nil {
+			usedLib.Version = lib.Version.String()
+		}
+		if lib.InstallDir != nil {
+			usedLib.InstallDir = lib.InstallDir.String()
+		}
+		res.UsedLibraries = append(res.UsedLibraries, usedLib)
+	}
+
+	for _, tool := range ctx.RequiredTools {
+		toolRef := &rpc.InstalledToolReference{
+			Packager: tool.Tool.Package.Name,
+			Name:     tool.Tool.Name,
+			Version:  tool.Version.String(),
+		}
+		if tool.InstallDir != nil {
+			toolRef.InstallDir = tool.InstallDir.String()
+		}
+		res.Tools = append(res.Tools, toolRef)
+	}
+
+	if size := ctx.ExecutableSize; size != nil {
+		res.ExecutableSize = &rpc.ExecutableSize{
+			TextSize:    int64(size.TextSize),
+			MaxTextSize: int64(size.MaxTextSize),
+			DataSize:    int64(size.DataSize),
+			MaxDataSize: int64(size.MaxDataSize),
+			EepromSize:  int64(size.EepromSize),
+		}
+	}
+	return res
+}
+
+// buildArtifacts returns the files produced by the build for the given
+// output file, e.g.: sketch.ino.hex, sketch.ino.with_bootloader.hex,
+// sketch.ino.elf, sketch.ino.eep
+func buildArtifacts(outputPath *paths.Path) []string {
+	base := strings.TrimSuffix(outputPath.Base(), outputPath.Ext()) // "sketch.ino"
+	files, err := outputPath.Parent().ReadDir()
+	if err != nil {
+		return nil
+	}
+	res := []string{}
+	for _, file := range files {
+		if !file.IsDir() && strings.HasPrefix(file.Base(), base+".") {
+			res = append(res, file.String())
+		}
+	}
+	return res
+}
+
+func resultProperties(buildProperties *properties.Map) map[string]string {
+	res := map[string]string{}
+	for _, key := range buildProperties.Keys() {
+		for _, prefix := range resultPropertiesPrefixes {
+			if strings.HasPrefix(key, prefix) {
+				res[key] = buildProperties.ExpandPropsInString(buildProperties.Get(key))
+				break
+			}
+		}
+	}
+	return res
+}
+
+func platformReference(platform *cores.PlatformRelease) *rpc.InstalledPlatformReference {
+	if platform == nil {
+		return nil
+	}
+	res := &rpc.InstalledPlatformReference{Id: platform.Platform.String()}
+	if platform.Version != nil {
+		res.Version = platform.Version.String()
+	}
+	if platform.InstallDir != nil {
+		res.InstallDir = platform.InstallDir.String()
+	}
+	return res
+}
+
+func compileDiagnostics(diagnostics []*builder.Diagnostic) []*rpc.CompileDiagnostic {
+	res := []*rpc.CompileDiagnostic{}
+	for _, diagnostic := range diagnostics {
+		res = append(res, &rpc.CompileDiagnostic{
+			File:     diagnostic.File,
+			Line:     int32(diagnostic.Line),
+			Column:   int32(diagnostic.Column),
+			Severity: diagnostic.Severity,
+			Message:  diagnostic.Message,
+			Notes:    compileDiagnostics(diagnostic.Notes),
+		})
+	}
+	return res
+}
@@ -0,0 +1,88 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestCompileResult(t *testing.T) {
+	buildPath, err := paths.MkTempDir("", "compile-result-test")
+	require.NoError(t, err)
+	defer buildPath.RemoveAll()
+	for _, file := range []string{"sketch.ino.hex", "sketch.ino.with_bootloader.hex", "sketch.ino.elf", "sketch.ino.cpp.o", "build.options.json"} {
+		require.NoError(t, buildPath.Join(file).WriteFile([]byte{}))
+	}
+	require.NoError(t, buildPath.Join("sketch").MkdirAll())
+
+	props := properties.NewMap()
+	props.SetPath("build.path", buildPath)
+	props.Set("build.project_name", "sketch.ino")
+	props.Set("build.mcu", "atmega328p")
+	props.Set("runtime.ide.version", "10607")
+	props.Set("recipe.output.tmp_file", "{build.project_name}.hex")
+	props.Set("compiler.c.flags", "-Os")
+	props.Set("upload.maximum_size", "32256")
+
+	avr := &cores.Platform{Architecture: "avr", Package: &cores.Package{Name: "arduino"}}
+	platform := &cores.PlatformRelease{Platform: avr, Version: semver.MustParse("1.8.1")}
+	ctx := &types.Context{
+		BuildPath:       buildPath,
+		BuildProperties: props,
+		TargetPlatform:  platform,
+		ActualPlatform:  platform,
+		ImportedLibraries: libraries.List{
+			&libraries.Library{Name: "Servo", Version: semver.MustParse("1.1.6"), Location: libraries.Sketchbook},
+		},
+		ExecutableSize: &types.ExecutableSize{TextSize: 924, MaxTextSize: 32256, DataSize: 9, MaxDataSize: 2048, EepromSize: -1},
+	}
+
+	res := compileResult(ctx, buildPath.Join("sketch.ino.hex"))
+	require.Equal(t, buildPath.String(), res.BuildPath)
+	require.ElementsMatch(t, []string{
+		buildPath.Join("sketch.ino.hex").String(),
+		buildPath.Join("sketch.ino.with_bootloader.hex").String(),
+		buildPath.Join("sketch.ino.elf").String(),
+		buildPath.Join("sketch.ino.cpp.o").String(),
+	}, res.Artifacts)
+	require.Equal(t, map[string]string{
+		"build.path":             buildPath.String(),
+		"build.project_name":     "sketch.ino",
+		"build.mcu":              "atmega328p",
+		"runtime.ide.version":    "10607",
+		"recipe.output.tmp_file": "sketch.ino.hex",
+		"upload.maximum_size":    "32256",
+	}, res.BuildProperties)
+	require.Equal(t, "arduino:avr", res.BoardPlatform.Id)
+	require.Equal(t, "1.8.1", res.BoardPlatform.Version)
+	require.Nil(t, res.BuildPlatform)
+	require.Len(t, res.UsedLibraries, 1)
+	require.Equal(t, "Servo", res.UsedLibraries[0].Name)
+	require.Equal(t, "1.1.6", res.UsedLibraries[0].Version)
+	require.Equal(t, "sketchbook", res.UsedLibraries[0].Location)
+	require.Equal(t, int64(924), res.ExecutableSize.TextSize)
+	require.Equal(t, int64(-1), res.ExecutableSize.EepromSize)
+}
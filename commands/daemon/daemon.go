@@ -33,6 +33,8 @@ import (
 	"github.com/arduino/arduino-cli/commands/upload"
 	"github.com/arduino/arduino-cli/configs"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // ArduinoCoreServerImpl FIXMEDOC
@@ -59,6 +61,21 @@ func (s *ArduinoCoreServerImpl) BoardList(ctx context.Context, req *rpc.BoardLis
 	}, nil
 }
 
+// BoardListWatch FIXMEDOC
+func (s *ArduinoCoreServerImpl) BoardListWatch(req *rpc.BoardListWatchReq, stream rpc.ArduinoCore_BoardListWatchServer) error {
+	events, err := board.Watch(stream.Context(), req.GetInstance().GetId())
+	if err != nil {
+		return err
+	}
+
+	for event := range events {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // BoardListAll FIXMEDOC
 func (s *ArduinoCoreServerImpl) BoardListAll(ctx context.Context, req *rpc.BoardListAllReq) (*rpc.BoardListAllResp, error) {
 	return board.ListAll(ctx, req)
@@ -133,14 +150,25 @@ func (s *ArduinoCoreServerImpl) Compile(req *rpc.CompileReq, stream rpc.ArduinoC
 		stream.Context(), req,
 		feedStream(func(data []byte) { stream.Send(&rpc.CompileResp{OutStream: data}) }),
 		feedStream(func(data []byte) { stream.Send(&rpc.CompileResp{ErrStream: data}) }),
+		func(p *rpc.TaskProgress) { stream.Send(&rpc.CompileResp{TaskProgress: p}) },
+		func(d []*rpc.CompileDiagnostic) { stream.Send(&rpc.CompileResp{Diagnostics: d}) },
 		s.Config,
 		false) // set debug to false
 	if err != nil {
-		return err
+		if resp != nil {
+			// the results of the boards compiled successfully
+			stream.Send(resp)
+		}
+		return uploadErrorStatus(err)
 	}
 	return stream.Send(resp)
 }
 
+// CodeComplete FIXMEDOC
+func (s *ArduinoCoreServerImpl) CodeComplete(ctx context.Context, req *rpc.CodeCompleteReq) (*rpc.CodeCompleteResp, error) {
+	return compile.CodeComplete(ctx, req, s.Config, false)
+}
+
 // PlatformInstall FIXMEDOC
 func (s *ArduinoCoreServerImpl) PlatformInstall(req *rpc.PlatformInstallReq, stream rpc.ArduinoCore_PlatformInstallServer) error {
 	resp, err := core.PlatformInstall(
@@ -222,6 +250,38 @@ func (s *ArduinoCoreServerImpl) Upload(req *rpc.UploadReq, stream rpc.ArduinoCor
 		stream.Context(), req,
 		feedStream(func(data []byte) { stream.Send(&rpc.UploadResp{OutStream: data}) }),
 		feedStream(func(data []byte) { stream.Send(&rpc.UploadResp{ErrStream: data}) }),
+		func(p *rpc.UploadProgress) { stream.Send(&rpc.UploadResp{Progress: p}) },
+	)
+	if err != nil {
+		return uploadErrorStatus(err)
+	}
+	return stream.Send(resp)
+}
+
+// MultiUpload FIXMEDOC
+func (s *ArduinoCoreServerImpl) MultiUpload(req *rpc.MultiUploadReq, stream rpc.ArduinoCore_MultiUploadServer) error {
+	return upload.MultiUpload(stream.Context(), req, func(resp *rpc.MultiUploadResp) { stream.Send(resp) })
+}
+
+// uploadErrorStatus converts the errors of an interrupted upload into the
+// corresponding gRPC status, so that clients can tell a cancelled or timed
+// out upload from a failure of the upload tool
+func uploadErrorStatus(err error) error {
+	switch err {
+	case upload.ErrUploadCancelled:
+		return status.Error(codes.Canceled, err.Error())
+	case upload.ErrUploadTimeout:
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	return err
+}
+
+// BurnBootloader FIXMEDOC
+func (s *ArduinoCoreServerImpl) BurnBootloader(req *rpc.BurnBootloaderReq, stream rpc.ArduinoCore_BurnBootloaderServer) error {
+	resp, err := upload.BurnBootloader(
+		stream.Context(), req,
+		feedStream(func(data []byte) { stream.Send(&rpc.BurnBootloaderResp{OutStream: data}) }),
+		feedStream(func(data []byte) { stream.Send(&rpc.BurnBootloaderResp{ErrStream: data}) }),
 	)
 	if err != nil {
 		return err
@@ -271,6 +331,11 @@ func (s *ArduinoCoreServerImpl) LibraryInstall(req *rpc.LibraryInstallReq, strea
 	return stream.Send(&rpc.LibraryInstallResp{})
 }
 
+// LibraryResolveDependencies FIXMEDOC
+func (s *ArduinoCoreServerImpl) LibraryResolveDependencies(ctx context.Context, req *rpc.LibraryResolveDependenciesReq) (*rpc.LibraryResolveDependenciesResp, error) {
+	return lib.LibraryResolveDependencies(ctx, req)
+}
+
 // LibraryUninstall FIXMEDOC
 func (s *ArduinoCoreServerImpl) LibraryUninstall(req *rpc.LibraryUninstallReq, stream rpc.ArduinoCore_LibraryUninstallServer) error {
 	err := lib.LibraryUninstall(stream.Context(), req,
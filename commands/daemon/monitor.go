@@ -16,15 +16,32 @@
 package daemon
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"strings"
+	"sync"
 
 	"github.com/arduino/arduino-cli/arduino/monitors"
 	rpc "github.com/arduino/arduino-cli/rpc/monitor"
+	paths "github.com/arduino/go-paths-helper"
+	st "github.com/golang/protobuf/ptypes/struct"
+	"github.com/sirupsen/logrus"
 )
 
 // MonitorService implements the `Monitor` service
-type MonitorService struct{}
+type MonitorService struct {
+	// RecordsDir is the directory where the sessions are recorded, the
+	// `record_file` of the clients is relative to it. If nil the sessions
+	// can't be recorded.
+	RecordsDir *paths.Path
+
+	sharedMux sync.Mutex
+	shared    map[string]*sharedMonitor
+	// opening contains the shared targets being opened, the channels are
+	// closed when the targets are open
+	opening map[string]chan struct{}
+}
 
 // StreamingOpen returns a stream response that can be used to fetch data from the
 // monitor target. The first message passed through the `StreamingOpenReq` must
@@ -42,31 +59,17 @@ func (s *MonitorService) StreamingOpen(stream rpc.Monitor_StreamingOpenServer) e
 		return fmt.Errorf("first message must contain monitor configuration, not data")
 	}
 
-	// select which type of monitor we need
-	var mon monitors.Monitor
-	switch config.GetType() {
-	case rpc.MonitorConfig_SERIAL:
-		// grab port speed from additional config data
-		var baudRate float64
-		addCfg := config.GetAdditionalConfig()
-		for k, v := range addCfg.GetFields() {
-			if k == "BaudRate" {
-				baudRate = v.GetNumberValue()
-				break
-			}
-		}
-
-		// get the Monitor instance
-		var err error
-		if mon, err = monitors.OpenSerialMonitor(config.GetTarget(), int(baudRate)); err != nil {
-			return err
-		}
+	// open the target, or attach to it if shared
+	client, err := s.openMonitor(config)
+	if err != nil {
+		return err
 	}
+	buffer := client.buffer
 
 	// we'll use these channels to communicate with the goroutines
 	// handling the stream and the target respectively
-	streamClosed := make(chan error)
-	targetClosed := make(chan error)
+	streamClosed := make(chan error, 2)
+	targetClosed := make(chan error, 2)
 
 	// now we can read the other messages and re-route to the monitor...
 	go func() {
@@ -84,7 +87,30 @@ func (s *MonitorService) StreamingOpen(stream rpc.Monitor_StreamingOpenServer) e
 				break
 			}
 
-			if _, err := mon.Write(msg.GetData()); err != nil {
+			if control := msg.GetControl(); control != nil {
+				// change the settings of the target
+				if err := client.Configure(control); err == errNotExclusiveWriter {
+					// another client holds the target, the request is dropped
+					// but the client can keep reading
+					logrus.Warnf("Monitor %s: settings change rejected: %s", client.shared.key, err)
+				} else if err != nil {
+					targetClosed <- err
+					break
+				}
+				continue
+			}
+
+			if ack := msg.GetRecvAcknowledge(); ack > 0 {
+				// the client is ready to receive more data
+				buffer.acknowledge(int(ack))
+				continue
+			}
+
+			if _, err := client.Write(msg.GetData()); err == errNotExclusiveWriter {
+				// another client holds the target, the data is dropped
+				// but the client can keep reading
+				logrus.Warnf("Monitor %s: %d bytes dropped: %s", client.shared.key, len(msg.GetData()), err)
+			} else if err != nil {
 				// error writing to target
 				targetClosed <- err
 				break
@@ -92,30 +118,18 @@ func (s *MonitorService) StreamingOpen(stream rpc.Monitor_StreamingOpenServer) e
 		}
 	}()
 
-	// ...and read from the monitor and forward to the output stream
+	// ...and forward the data received from the target to the output stream
 	go func() {
-		buf := make([]byte, 8)
-		for {
-			n, err := mon.Read(buf)
-			if err != nil {
-				// error reading from target
-				targetClosed <- err
-				break
-			}
-
-			if n == 0 {
-				// target was closed
-				targetClosed <- nil
-				break
-			}
-
-			if err = stream.Send(&rpc.StreamingOpenResp{
-				Data: buf[:n],
-			}); err != nil {
-				// error sending to stream
-				streamClosed <- err
-				break
-			}
+		var sendErr error
+		err := buffer.run(func(resp *rpc.StreamingOpenResp) error {
+			sendErr = stream.Send(resp)
+			return sendErr
+		})
+		if sendErr != nil {
+			// error sending to stream
+			streamClosed <- sendErr
+		} else {
+			targetClosed <- err
 		}
 	}()
 
@@ -125,10 +139,63 @@ func (s *MonitorService) StreamingOpen(stream rpc.Monitor_StreamingOpenServer) e
 	for {
 		select {
 		case err := <-streamClosed:
-			mon.Close()
+			client.Close()
 			return err
 		case err := <-targetClosed:
+			client.Close()
 			return err
 		}
 	}
 }
+
+// MonitorPortSettings returns the description of the settings supported by
+// the requested type of monitor target
+func (s *MonitorService) MonitorPortSettings(ctx context.Context, req *rpc.MonitorPortSettingsReq) (*rpc.MonitorPortSettingsResp, error) {
+	settings, err := monitors.Settings(strings.ToLower(req.GetType().String()))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &rpc.MonitorPortSettingsResp{}
+	for _, setting := range settings {
+		res.Settings = append(res.Settings, &rpc.MonitorPortSetting{
+			Id:           setting.ID,
+			Label:        setting.Label,
+			Type:         setting.Type,
+			Values:       setting.Values,
+			DefaultValue: setting.Default,
+		})
+	}
+	return res, nil
+}
+
+// structToMap converts the additional configuration of a monitor to a plain
+// map, as expected by monitors.Open
+func structToMap(s *st.Struct) map[string]interface{} {
+	res := map[string]interface{}{}
+	for k, v := range s.GetFields() {
+		res[k] = valueToInterface(v)
+	}
+	return res
+}
+
+func valueToInterface(v *st.Value) interface{} {
+	switch kind := v.GetKind().(type) {
+	case *st.Value_NumberValue:
+		return kind.NumberValue
+	case *st.Value_StringValue:
+		return kind.StringValue
+	case *st.Value_BoolValue:
+		return kind.BoolValue
+	case *st.Value_StructValue:
+		return structToMap(kind.StructValue)
+	case *st.Value_ListValue:
+		res := []interface{}{}
+		for _, item := range kind.ListValue.GetValues() {
+			res = append(res, valueToInterface(item))
+		}
+		return res
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,195 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"sync"
+	"time"
+
+	rpc "github.com/arduino/arduino-cli/rpc/monitor"
+)
+
+const (
+	defaultMonitorChunkSize  = 1024
+	defaultMonitorLatency    = 16 * time.Millisecond
+	defaultMonitorBufferSize = 64 * 1024
+)
+
+// monitorBuffer collects the data read from a monitor target and splits it
+// in chunks to be sent to the client. A chunk is released when it reaches
+// the maximum size or when its first byte has been waiting longer than the
+// maximum latency. If the client enabled the flow control, no more than
+// `window` bytes are released until the client acknowledges them. When the
+// buffer is full the incoming data is dropped.
+type monitorBuffer struct {
+	mux        sync.Mutex
+	notify     chan struct{}
+	data       []byte
+	firstByte  time.Time
+	chunkSize  int
+	latency    time.Duration
+	bufferSize int
+	window     int // -1 disables the flow control
+	closed     bool
+	closeErr   error
+	stats      rpc.MonitorStats
+}
+
+func newMonitorBuffer(config *rpc.MonitorBufferConfig) *monitorBuffer {
+	b := &monitorBuffer{
+		notify:     make(chan struct{}, 1),
+		chunkSize:  defaultMonitorChunkSize,
+		latency:    defaultMonitorLatency,
+		bufferSize: defaultMonitorBufferSize,
+		window:     -1,
+	}
+	if size := config.GetMaxChunkSize(); size > 0 {
+		b.chunkSize = int(size)
+	}
+	if latency := config.GetMaxLatencyMs(); latency > 0 {
+		b.latency = time.Duration(latency) * time.Millisecond
+	}
+	if size := config.GetBufferSize(); size > 0 {
+		b.bufferSize = int(size)
+	}
+	if b.bufferSize < b.chunkSize {
+		b.bufferSize = b.chunkSize
+	}
+	if window := config.GetWindowSize(); window > 0 {
+		b.window = int(window)
+	}
+	return b
+}
+
+func (b *monitorBuffer) wakeUp() {
+	select {
+	case b.notify <- struct{}{}:
+	default:
+	}
+}
+
+// push adds the data received from the target, the data exceeding the
+// buffer size is dropped
+func (b *monitorBuffer) push(data []byte) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	b.stats.RxBytes += uint64(len(data))
+	if len(b.data) == 0 {
+		b.firstByte = time.Now()
+	}
+	if free := b.bufferSize - len(b.data); len(data) > free {
+		b.stats.DroppedBytes += uint64(len(data) - free)
+		data = data[:free]
+	}
+	b.data = append(b.data, data...)
+	b.wakeUp()
+}
+
+// written updates the statistics with the data sent to the target
+func (b *monitorBuffer) written(n int) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	b.stats.TxBytes += uint64(n)
+}
+
+// acknowledge extends the flow control window of n bytes
+func (b *monitorBuffer) acknowledge(n int) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	if b.window >= 0 {
+		b.window += n
+		b.wakeUp()
+	}
+}
+
+// close marks the end of the data, the pending data is still released
+// if the flow control window allows it
+func (b *monitorBuffer) close(err error) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	b.closed = true
+	b.closeErr = err
+	b.wakeUp()
+}
+
+// pop returns the next chunk to send, if any, together with the current
+// statistics. If no chunk is ready it returns how long to wait before
+// trying again (0 means until the next event). done is true when the
+// buffer has been closed and there is nothing left to send.
+func (b *monitorBuffer) pop() (chunk []byte, stats *rpc.MonitorStats, wait time.Duration, done bool) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	if len(b.data) == 0 || b.window == 0 {
+		if b.closed {
+			// nothing more can be sent
+			b.stats.DroppedBytes += uint64(len(b.data))
+			b.data = nil
+			return nil, nil, 0, true
+		}
+		return nil, nil, 0, false
+	}
+
+	elapsed := time.Since(b.firstByte)
+	if len(b.data) < b.chunkSize && elapsed < b.latency && !b.closed {
+		return nil, nil, b.latency - elapsed, false
+	}
+
+	n := len(b.data)
+	if n > b.chunkSize {
+		n = b.chunkSize
+	}
+	if b.window >= 0 && n > b.window {
+		n = b.window
+	}
+	chunk = append([]byte{}, b.data[:n]...)
+	b.data = b.data[n:]
+	if b.window >= 0 {
+		b.window -= n
+	}
+	stats = &rpc.MonitorStats{
+		RxBytes:      b.stats.RxBytes,
+		TxBytes:      b.stats.TxBytes,
+		DroppedBytes: b.stats.DroppedBytes,
+	}
+	return chunk, stats, 0, false
+}
+
+// run sends the chunks to the client until the buffer is closed and
+// emptied, then returns the error that closed the buffer
+func (b *monitorBuffer) run(send func(*rpc.StreamingOpenResp) error) error {
+	for {
+		chunk, stats, wait, done := b.pop()
+		if done {
+			return b.closeErr
+		}
+		if chunk != nil {
+			if err := send(&rpc.StreamingOpenResp{Data: chunk, Stats: stats}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var timeout <-chan time.Time
+		if wait > 0 {
+			timeout = time.After(wait)
+		}
+		select {
+		case <-b.notify:
+		case <-timeout:
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	rpc "github.com/arduino/arduino-cli/rpc/monitor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorBufferCoalescing(t *testing.T) {
+	b := newMonitorBuffer(&rpc.MonitorBufferConfig{MaxChunkSize: 4, MaxLatencyMs: 1000})
+
+	// less than a chunk, wait for the latency to expire
+	b.push([]byte("ab"))
+	chunk, _, wait, done := b.pop()
+	require.Nil(t, chunk)
+	require.False(t, done)
+	require.True(t, wait > 0 && wait <= time.Second)
+
+	// a full chunk is released immediately
+	b.push([]byte("cdef"))
+	chunk, stats, _, _ := b.pop()
+	require.Equal(t, "abcd", string(chunk))
+	require.Equal(t, uint64(6), stats.GetRxBytes())
+
+	// the remaining data is flushed on close
+	b.close(nil)
+	chunk, _, _, done = b.pop()
+	require.Equal(t, "ef", string(chunk))
+	require.False(t, done)
+	_, _, _, done = b.pop()
+	require.True(t, done)
+}
+
+func TestMonitorBufferFlowControl(t *testing.T) {
+	b := newMonitorBuffer(&rpc.MonitorBufferConfig{MaxChunkSize: 4, BufferSize: 8, WindowSize: 2})
+
+	b.push([]byte("abcdefghij"))
+	chunk, stats, _, _ := b.pop()
+	require.Equal(t, "ab", string(chunk))
+	require.Equal(t, uint64(10), stats.GetRxBytes())
+	require.Equal(t, uint64(2), stats.GetDroppedBytes())
+
+	// window exhausted
+	chunk, _, wait, done := b.pop()
+	require.Nil(t, chunk)
+	require.Zero(t, wait)
+	require.False(t, done)
+
+	b.acknowledge(3)
+	chunk, _, _, _ = b.pop()
+	require.Equal(t, "cde", string(chunk))
+
+	// wait for the latency to release the last partial chunk
+	b.written(5)
+	b.acknowledge(10)
+	time.Sleep(2 * defaultMonitorLatency)
+	chunk, stats, _, _ = b.pop()
+	require.Equal(t, "fgh", string(chunk))
+	require.Equal(t, uint64(5), stats.GetTxBytes())
+}
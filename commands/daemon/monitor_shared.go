@@ -0,0 +1,317 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/arduino/arduino-cli/arduino/monitors"
+	rpc "github.com/arduino/arduino-cli/rpc/monitor"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/golang/protobuf/proto"
+)
+
+// sharedMonitor is a monitor target that can be attached by many clients:
+// the data received from the target is sent to all the clients and the
+// data sent by the clients is merged.
+type sharedMonitor struct {
+	key       string
+	mon       monitors.Monitor
+	config    *rpc.MonitorConfig
+	mux       sync.Mutex
+	writeMux  sync.Mutex
+	clients   map[*monitorClient]bool
+	exclusive *monitorClient
+	onClose   func()
+	closeOnce sync.Once
+	// closed is set, under mux, when the target is being closed: no more
+	// clients can be attached
+	closed bool
+}
+
+// monitorClient is a client attached to a sharedMonitor
+type monitorClient struct {
+	shared *sharedMonitor
+	buffer *monitorBuffer
+}
+
+var errNotExclusiveWriter = errors.New("the monitor target is reserved to another client for writing")
+
+var errMonitorClosed = errors.New("the monitor target is closed")
+
+// openMonitor opens the monitor target described by the configuration
+// and attaches a client to it. If the shared mode is requested and the
+// target is already open in shared mode, the client is attached to the
+// existing monitor.
+func (s *MonitorService) openMonitor(config *rpc.MonitorConfig) (*monitorClient, error) {
+	buffer := newMonitorBuffer(config.GetBuffer())
+	if !config.GetShared() {
+		shared, err := s.openTarget(config)
+		if err != nil {
+			return nil, err
+		}
+		client, _ := shared.attach(buffer, config)
+		go shared.readLoop()
+		return client, nil
+	}
+
+	key := strings.ToLower(config.GetType().String()) + "://" + config.GetTarget()
+	s.sharedMux.Lock()
+	for {
+		if opening, ok := s.opening[key]; ok {
+			// another client is opening the target, the lock is released
+			// to not block the clients of the other targets
+			s.sharedMux.Unlock()
+			<-opening
+			s.sharedMux.Lock()
+			continue
+		}
+		if shared, ok := s.shared[key]; ok {
+			client, err := shared.attach(buffer, config)
+			if err != errMonitorClosed {
+				s.sharedMux.Unlock()
+				return client, err
+			}
+			// the target is being closed, a new one is opened below
+		}
+		break
+	}
+	if s.opening == nil {
+		s.opening = map[string]chan struct{}{}
+	}
+	opening := make(chan struct{})
+	s.opening[key] = opening
+	s.sharedMux.Unlock()
+
+	shared, err := s.openTarget(config)
+
+	s.sharedMux.Lock()
+	defer s.sharedMux.Unlock()
+	delete(s.opening, key)
+	close(opening)
+	if err != nil {
+		return nil, err
+	}
+	if s.shared == nil {
+		s.shared = map[string]*sharedMonitor{}
+	}
+	s.shared[key] = shared
+	shared.onClose = func() {
+		s.sharedMux.Lock()
+		defer s.sharedMux.Unlock()
+		if s.shared[key] == shared {
+			delete(s.shared, key)
+		}
+	}
+	client, _ := shared.attach(buffer, config)
+	go shared.readLoop()
+	return client, nil
+}
+
+// openTarget opens the monitor target described by the configuration and
+// the record file, if requested
+func (s *MonitorService) openTarget(config *rpc.MonitorConfig) (*sharedMonitor, error) {
+	targetType := strings.ToLower(config.GetType().String())
+
+	var recordFile *paths.Path
+	if config.GetRecordFile() != "" {
+		var err error
+		if recordFile, err = s.recordFilePath(config.GetRecordFile()); err != nil {
+			return nil, err
+		}
+	}
+
+	// the replayed sessions are confined in the records directory too
+	target := config.GetTarget()
+	if targetType == "replay" {
+		captureFile, err := s.recordFilePath(target)
+		if err != nil {
+			return nil, err
+		}
+		target = captureFile.String()
+	}
+
+	// get the Monitor instance for the requested target type
+	mon, err := monitors.Open(targetType, target, structToMap(config.GetAdditionalConfig()))
+	if err != nil {
+		return nil, err
+	}
+	if recordFile != nil {
+		capture, err := createRecordFile(recordFile)
+		if err != nil {
+			mon.Close()
+			return nil, fmt.Errorf("creating record file: %s", err)
+		}
+		mon = monitors.NewRecordingMonitor(mon, capture)
+	}
+
+	return &sharedMonitor{
+		key:     targetType + "://" + config.GetTarget(),
+		mon:     mon,
+		config:  config,
+		clients: map[*monitorClient]bool{},
+	}, nil
+}
+
+// recordFilePath returns the path of the record file requested by a client,
+// the record files are confined in the records directory of the service
+func (s *MonitorService) recordFilePath(name string) (*paths.Path, error) {
+	if s.RecordsDir == nil {
+		return nil, errors.New("recording the monitor sessions is not enabled")
+	}
+	clean := filepath.Clean(name)
+	if filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return nil, fmt.Errorf("invalid file %s: it must be a path relative to the records directory", name)
+	}
+	return s.RecordsDir.Join(clean), nil
+}
+
+func createRecordFile(file *paths.Path) (*os.File, error) {
+	if err := file.Parent().MkdirAll(); err != nil {
+		return nil, err
+	}
+	return os.Create(file.String())
+}
+
+// attach attaches a client to the target, the settings requested by the
+// client must match the ones the target was opened with
+func (shared *sharedMonitor) attach(buffer *monitorBuffer, config *rpc.MonitorConfig) (*monitorClient, error) {
+	shared.mux.Lock()
+	defer shared.mux.Unlock()
+
+	if shared.closed {
+		return nil, errMonitorClosed
+	}
+	if len(config.GetAdditionalConfig().GetFields()) > 0 && !proto.Equal(config.GetAdditionalConfig(), shared.config.GetAdditionalConfig()) {
+		return nil, errors.New("the monitor target is already open with a different configuration")
+	}
+	if config.GetRecordFile() != "" && config.GetRecordFile() != shared.config.GetRecordFile() {
+		return nil, errors.New("the monitor target is already open with a different record file")
+	}
+	client := &monitorClient{shared: shared, buffer: buffer}
+	if config.GetExclusiveWriter() {
+		if shared.exclusive != nil {
+			return nil, errors.New("another client is already the exclusive writer of the monitor target")
+		}
+		shared.exclusive = client
+	}
+	shared.clients[client] = true
+	return client, nil
+}
+
+// readLoop reads from the target and sends the data to all the clients
+// until the target is closed
+func (shared *sharedMonitor) readLoop() {
+	buf := make([]byte, 4096)
+	for {
+		n, err := shared.mon.Read(buf)
+		if err == io.EOF || (err == nil && n == 0) {
+			// target was closed
+			err = nil
+		} else if err == nil {
+			shared.mux.Lock()
+			for client := range shared.clients {
+				client.buffer.push(buf[:n])
+			}
+			shared.mux.Unlock()
+			continue
+		}
+
+		// error reading from target
+		shared.mux.Lock()
+		shared.closed = true
+		for client := range shared.clients {
+			client.buffer.close(err)
+		}
+		shared.mux.Unlock()
+		shared.close()
+		return
+	}
+}
+
+func (shared *sharedMonitor) close() {
+	shared.closeOnce.Do(func() {
+		if shared.onClose != nil {
+			shared.onClose()
+		}
+		shared.mon.Close()
+	})
+}
+
+// checkWriter returns an error if another client is the exclusive writer
+func (client *monitorClient) checkWriter() error {
+	shared := client.shared
+	shared.mux.Lock()
+	defer shared.mux.Unlock()
+	if shared.exclusive != nil && shared.exclusive != client {
+		return errNotExclusiveWriter
+	}
+	return nil
+}
+
+// Write sends data to the target, the data sent by the different clients
+// is never interleaved
+func (client *monitorClient) Write(data []byte) (int, error) {
+	if err := client.checkWriter(); err != nil {
+		return 0, err
+	}
+	client.shared.writeMux.Lock()
+	defer client.shared.writeMux.Unlock()
+	n, err := client.shared.mon.Write(data)
+	client.buffer.written(n)
+	return n, err
+}
+
+// Configure changes the settings of the target
+func (client *monitorClient) Configure(control *rpc.MonitorControl) error {
+	if err := client.checkWriter(); err != nil {
+		return err
+	}
+	configurable, ok := client.shared.mon.(monitors.Configurable)
+	if !ok {
+		return fmt.Errorf("the monitor target doesn't support changing settings")
+	}
+	client.shared.writeMux.Lock()
+	defer client.shared.writeMux.Unlock()
+	return configurable.Configure(structToMap(control.GetSettings()))
+}
+
+// Close detaches the client from the target, the target is closed when
+// the last client is detached
+func (client *monitorClient) Close() {
+	shared := client.shared
+	shared.mux.Lock()
+	delete(shared.clients, client)
+	if shared.exclusive == client {
+		shared.exclusive = nil
+	}
+	last := len(shared.clients) == 0
+	if last {
+		shared.closed = true
+	}
+	shared.mux.Unlock()
+
+	client.buffer.close(nil)
+	if last {
+		shared.close()
+	}
+}
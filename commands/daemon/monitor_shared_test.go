@@ -0,0 +1,240 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2019 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/monitor"
+	paths "github.com/arduino/go-paths-helper"
+	st "github.com/golang/protobuf/ptypes/struct"
+	"github.com/stretchr/testify/require"
+)
+
+func readChunk(t *testing.T, client *monitorClient) string {
+	var res string
+	err := client.buffer.run(func(resp *rpc.StreamingOpenResp) error {
+		res = string(resp.GetData())
+		return errStopTest
+	})
+	require.Equal(t, errStopTest, err)
+	return res
+}
+
+var errStopTest = errors.New("stop")
+
+func TestSharedMonitor(t *testing.T) {
+	s := &MonitorService{}
+	config := &rpc.MonitorConfig{Type: rpc.MonitorConfig_LOOPBACK, Target: "shared", Shared: true}
+
+	first, err := s.openMonitor(config)
+	require.NoError(t, err)
+	second, err := s.openMonitor(config)
+	require.NoError(t, err)
+	require.True(t, first.shared == second.shared)
+
+	// a non shared client gets its own monitor
+	private, err := s.openMonitor(&rpc.MonitorConfig{Type: rpc.MonitorConfig_LOOPBACK, Target: "shared"})
+	require.NoError(t, err)
+	require.True(t, first.shared != private.shared)
+	private.Close()
+
+	// the data received is sent to all the clients
+	_, err = first.Write([]byte("Hello"))
+	require.NoError(t, err)
+	require.Equal(t, "Hello", readChunk(t, first))
+	require.Equal(t, "Hello", readChunk(t, second))
+
+	// the target is closed when the last client is detached
+	first.Close()
+	require.Contains(t, s.shared, "loopback://shared")
+	second.Close()
+	require.NotContains(t, s.shared, "loopback://shared")
+}
+
+func TestSharedMonitorExclusiveWriter(t *testing.T) {
+	s := &MonitorService{}
+	config := &rpc.MonitorConfig{Type: rpc.MonitorConfig_LOOPBACK, Target: "exclusive", Shared: true}
+	exclusiveConfig := &rpc.MonitorConfig{Type: rpc.MonitorConfig_LOOPBACK, Target: "exclusive", Shared: true, ExclusiveWriter: true}
+
+	reader, err := s.openMonitor(config)
+	require.NoError(t, err)
+	defer reader.Close()
+	writer, err := s.openMonitor(exclusiveConfig)
+	require.NoError(t, err)
+
+	_, err = s.openMonitor(exclusiveConfig)
+	require.Error(t, err)
+
+	_, err = reader.Write([]byte("Hello"))
+	require.Equal(t, errNotExclusiveWriter, err)
+	_, err = writer.Write([]byte("Hello"))
+	require.NoError(t, err)
+	require.Equal(t, "Hello", readChunk(t, reader))
+
+	// the reservation ends when the writer is detached
+	writer.Close()
+	_, err = reader.Write([]byte("Hello"))
+	require.NoError(t, err)
+}
+
+type scriptedStream struct {
+	rpc.Monitor_StreamingOpenServer
+	reqs []*rpc.StreamingOpenReq
+}
+
+func (s *scriptedStream) Recv() (*rpc.StreamingOpenReq, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *scriptedStream) Send(*rpc.StreamingOpenResp) error { return nil }
+
+func TestSharedMonitorRejectedWriteKeepsStream(t *testing.T) {
+	s := &MonitorService{}
+	config := &rpc.MonitorConfig{Type: rpc.MonitorConfig_LOOPBACK, Target: "rejected", Shared: true}
+	exclusiveConfig := &rpc.MonitorConfig{Type: rpc.MonitorConfig_LOOPBACK, Target: "rejected", Shared: true, ExclusiveWriter: true}
+
+	writer, err := s.openMonitor(exclusiveConfig)
+	require.NoError(t, err)
+	defer writer.Close()
+
+	// the data sent by the reader is dropped, the stream is closed
+	// by the client and not by the rejected write
+	stream := &scriptedStream{reqs: []*rpc.StreamingOpenReq{
+		{Content: &rpc.StreamingOpenReq_MonitorConfig{MonitorConfig: config}},
+		{Content: &rpc.StreamingOpenReq_Data{Data: []byte("Hello")}},
+	}}
+	require.NoError(t, s.StreamingOpen(stream))
+}
+
+func TestSharedMonitorClosedTarget(t *testing.T) {
+	s := &MonitorService{}
+	config := &rpc.MonitorConfig{Type: rpc.MonitorConfig_LOOPBACK, Target: "closed", Shared: true}
+
+	first, err := s.openMonitor(config)
+	require.NoError(t, err)
+	defer first.Close()
+
+	// the read loop of the target has exited but the target is
+	// still registered: a new target is opened
+	first.shared.mux.Lock()
+	first.shared.closed = true
+	first.shared.mux.Unlock()
+	_, err = first.shared.attach(newMonitorBuffer(nil), config)
+	require.Equal(t, errMonitorClosed, err)
+
+	second, err := s.openMonitor(config)
+	require.NoError(t, err)
+	defer second.Close()
+	require.True(t, first.shared != second.shared)
+	require.True(t, s.shared["loopback://closed"] == second.shared)
+}
+
+func TestSharedMonitorSettingsMismatch(t *testing.T) {
+	s := &MonitorService{RecordsDir: paths.New("records")}
+	settings := func(value string) *st.Struct {
+		return &st.Struct{Fields: map[string]*st.Value{"setting": {Kind: &st.Value_StringValue{StringValue: value}}}}
+	}
+	config := &rpc.MonitorConfig{Type: rpc.MonitorConfig_LOOPBACK, Target: "settings", Shared: true, AdditionalConfig: settings("A")}
+
+	first, err := s.openMonitor(config)
+	require.NoError(t, err)
+	defer first.Close()
+
+	// the clients can join without settings or with the same settings
+	same, err := s.openMonitor(&rpc.MonitorConfig{Type: rpc.MonitorConfig_LOOPBACK, Target: "settings", Shared: true, AdditionalConfig: settings("A")})
+	require.NoError(t, err)
+	same.Close()
+	plain, err := s.openMonitor(&rpc.MonitorConfig{Type: rpc.MonitorConfig_LOOPBACK, Target: "settings", Shared: true})
+	require.NoError(t, err)
+	plain.Close()
+
+	_, err = s.openMonitor(&rpc.MonitorConfig{Type: rpc.MonitorConfig_LOOPBACK, Target: "settings", Shared: true, AdditionalConfig: settings("B")})
+	require.EqualError(t, err, "the monitor target is already open with a different configuration")
+	_, err = s.openMonitor(&rpc.MonitorConfig{Type: rpc.MonitorConfig_LOOPBACK, Target: "settings", Shared: true, RecordFile: "session.log"})
+	require.EqualError(t, err, "the monitor target is already open with a different record file")
+	require.Len(t, first.shared.clients, 1)
+}
+
+func TestSharedMonitorConcurrentOpen(t *testing.T) {
+	s := &MonitorService{}
+	config := &rpc.MonitorConfig{Type: rpc.MonitorConfig_LOOPBACK, Target: "concurrent", Shared: true}
+
+	clients := make([]*monitorClient, 10)
+	var wg sync.WaitGroup
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			client, err := s.openMonitor(config)
+			require.NoError(t, err)
+			clients[i] = client
+		}(i)
+	}
+	wg.Wait()
+	for _, client := range clients {
+		require.True(t, client.shared == clients[0].shared)
+	}
+	require.Empty(t, s.opening)
+	for _, client := range clients {
+		client.Close()
+	}
+	require.NotContains(t, s.shared, "loopback://concurrent")
+}
+
+func TestRecordFilePath(t *testing.T) {
+	s := &MonitorService{}
+	_, err := s.recordFilePath("session.log")
+	require.Error(t, err)
+
+	s.RecordsDir = paths.New("records")
+	file, err := s.recordFilePath("session.log")
+	require.NoError(t, err)
+	require.Equal(t, paths.New("records", "session.log").String(), file.String())
+	file, err = s.recordFilePath("board/../uno/session.log")
+	require.NoError(t, err)
+	require.Equal(t, paths.New("records", "uno", "session.log").String(), file.String())
+
+	for _, name := range []string{"../session.log", "uno/../../session.log", "..", paths.TempDir().Join("session.log").String()} {
+		_, err = s.recordFilePath(name)
+		require.Error(t, err, name)
+	}
+}
+
+func TestReplayConfinedInRecordsDir(t *testing.T) {
+	recordsDir, err := paths.MkTempDir("", "monitor-records")
+	require.NoError(t, err)
+	defer recordsDir.RemoveAll()
+	require.NoError(t, recordsDir.Join("session.log").WriteFile([]byte{}))
+
+	s := &MonitorService{RecordsDir: recordsDir}
+	_, err = s.openMonitor(&rpc.MonitorConfig{Type: rpc.MonitorConfig_REPLAY, Target: "../session.log"})
+	require.Error(t, err)
+	_, err = s.openMonitor(&rpc.MonitorConfig{Type: rpc.MonitorConfig_REPLAY, Target: recordsDir.Join("session.log").String()})
+	require.Error(t, err)
+
+	client, err := s.openMonitor(&rpc.MonitorConfig{Type: rpc.MonitorConfig_REPLAY, Target: "session.log"})
+	require.NoError(t, err)
+	client.Close()
+}
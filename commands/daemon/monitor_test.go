@@ -21,6 +21,7 @@ var (
 	resBaudRate        int
 	resWrittenToSerial []byte
 	resReadFromSerial  []byte
+	dataSent           = make(chan bool, 1)
 )
 
 type TestStreamingOpenServer struct{}
@@ -28,7 +29,10 @@ type TestStreamingOpenServer struct{}
 func (s *TestStreamingOpenServer) Send(mon *monitor.StreamingOpenResp) error {
 	// if we're here, the Monitor read something from the target and
 	// is sending it back to the stream client
-	resReadFromSerial = mon.GetData()
+	if resReadFromSerial == nil {
+		resReadFromSerial = mon.GetData()
+		dataSent <- true
+	}
 	return nil
 }
 
@@ -64,6 +68,8 @@ func (s *TestStreamingOpenServer) Recv() (*monitor.StreamingOpenReq, error) {
 		}, nil
 	}
 
+	// wait for the monitor to send some data before closing the stream
+	<-dataSent
 	return nil, io.EOF
 }
 
@@ -74,14 +80,14 @@ func (s *TestStreamingOpenServer) Context() context.Context     { return context
 func (s *TestStreamingOpenServer) SendMsg(m interface{}) error  { return nil }
 func (s *TestStreamingOpenServer) RecvMsg(m interface{}) error  { return nil }
 
-func mockOpenSerialMonitor(portName string, baudRate int) (*monitors.SerialMonitor, error) {
+func mockOpenSerialMonitor(portName string, config *monitors.SerialConfig) (*monitors.SerialMonitor, error) {
 	// this function will be called by the Monitor as soon as it receives the
 	// first message from the stream client
 
 	// save parameters so the Test function can assert on the values passed to the monitor
 	// by the client
 	resPortName = portName
-	resBaudRate = baudRate
+	resBaudRate = config.BaudRate
 
 	mon := &monitors.SerialMonitor{}
 	monkey.PatchInstanceMethod(reflect.TypeOf(mon), "Close", func(_ *monitors.SerialMonitor) error {
@@ -100,7 +106,7 @@ func mockOpenSerialMonitor(portName string, baudRate int) (*monitors.SerialMonit
 }
 
 func TestFoo(t *testing.T) {
-	monkey.Patch(monitors.OpenSerialMonitor, mockOpenSerialMonitor)
+	monkey.Patch(monitors.OpenSerialMonitorWithConfig, mockOpenSerialMonitor)
 
 	svc := daemon.MonitorService{}
 	stream := &TestStreamingOpenServer{}
@@ -116,7 +122,29 @@ func TestFoo(t *testing.T) {
 	// ensure the serial received the message
 	assert.Equal(t, []byte("Hello Serial, this if for you!"), resWrittenToSerial)
 
-	// ensure the monitor read from the serial, output is truncated because the test
-	// doesn't consume the whole buffer
-	assert.Equal(t, []byte("I am Ser"), resReadFromSerial)
+	// ensure the monitor read from the serial, the data is coalesced in
+	// chunks of the default size
+	assert.Len(t, resReadFromSerial, 1024)
+	assert.Equal(t, []byte("I am Serial"), resReadFromSerial[:11])
+}
+
+type unknownTargetStreamingOpenServer struct {
+	TestStreamingOpenServer
+}
+
+func (s *unknownTargetStreamingOpenServer) Recv() (*monitor.StreamingOpenReq, error) {
+	return &monitor.StreamingOpenReq{
+		Content: &monitor.StreamingOpenReq_MonitorConfig{
+			MonitorConfig: &monitor.MonitorConfig{
+				Target: "/dev/tty42",
+				Type:   monitor.MonitorConfig_TargetType(42),
+			},
+		},
+	}, nil
+}
+
+func TestUnknownTargetType(t *testing.T) {
+	svc := daemon.MonitorService{}
+	err := svc.StreamingOpen(&unknownTargetStreamingOpenServer{})
+	assert.EqualError(t, err, "unsupported monitor type: 42")
 }
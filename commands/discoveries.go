@@ -0,0 +1,174 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/discovery"
+	"github.com/arduino/arduino-cli/arduino/discovery/discoverymanager"
+	"github.com/arduino/arduino-cli/arduino/discovery/mdns"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/sirupsen/logrus"
+)
+
+var mutex = sync.Mutex{}
+
+// BoardPort is a generic port descriptor
+type BoardPort = discovery.Port
+
+// BoardPortEvent is an event sent by a discovery when a port is
+// connected ("add") or disconnected ("remove")
+type BoardPortEvent = discovery.Event
+
+// networkDiscovery is shared by all the DiscoveryManagers: it browses the
+// network in the background, so only the first listing of the boards waits
+// for the mDNS responses
+var networkDiscovery = mdns.New()
+
+// StartNetworkDiscovery starts browsing the network boards in the
+// background, the boards found are returned by the following ListBoards
+func StartNetworkDiscovery() {
+	networkDiscovery.Start()
+}
+
+// StopNetworkDiscovery stops browsing the network boards, the browsing is
+// started again by the next ListBoards
+func StopNetworkDiscovery() {
+	networkDiscovery.Stop()
+}
+
+// GetDiscoveryManager returns a DiscoveryManager containing the bundled
+// serial-discovery, the mDNS discovery of the network boards and all the
+// discoveries declared by the installed platforms. A platform declares a
+// discovery by adding the recipe to run it in the platform.txt:
+//
+//	discovery.DISCOVERY_ID.pattern="{runtime.tools.my-discovery.path}/my-discovery"
+//
+// The discoveries that can't be loaded are skipped and a warning is logged.
+func GetDiscoveryManager(pm *packagemanager.PackageManager) *discoverymanager.DiscoveryManager {
+	dm := discoverymanager.New()
+
+	if serialDiscovery, err := getBuiltinSerialDiscovery(pm); err != nil {
+		logrus.WithError(err).Warn("Loading bundled serial-discovery")
+	} else {
+		dm.Add(serialDiscovery)
+	}
+	dm.Add(networkDiscovery)
+
+	for _, platform := range pm.InstalledPlatformReleases() {
+		discoveriesProps := platform.Properties.SubTree("discovery").FirstLevelOf()
+		if len(discoveriesProps) == 0 {
+			continue
+		}
+
+		// Build the configuration needed to expand the recipes
+		props := properties.NewMap()
+		props.Merge(platform.Properties)
+		props.Merge(platform.RuntimeProperties())
+		for _, dep := range platform.Dependencies {
+			if tool := pm.FindToolDependency(dep); tool != nil {
+				props.Merge(tool.RuntimeProperties())
+			}
+		}
+
+		ids := []string{}
+		for id := range discoveriesProps {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			log := logrus.WithField("platform", platform).WithField("discovery", id)
+			pattern, ok := discoveriesProps[id].GetOk("pattern")
+			if !ok {
+				log.Warn("Missing discovery pattern")
+				continue
+			}
+			cmdLine := props.ExpandPropsInString(pattern)
+			args, err := properties.SplitQuotedString(cmdLine, `"'`, false)
+			if err != nil {
+				log.WithError(err).Warn("Invalid discovery pattern")
+				continue
+			}
+			if err := dm.Add(discovery.New(id, args...)); err != nil {
+				log.WithError(err).Warn("Loading discovery")
+			}
+		}
+	}
+	return dm
+}
+
+// ListBoards returns the ports detected by all the available discoveries
+func ListBoards(pm *packagemanager.PackageManager) ([]*BoardPort, error) {
+	// ensure the connection to the discoverers is unique to avoid messing up
+	// the messages exchanged
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	dm := GetDiscoveryManager(pm)
+	ports, errs := dm.List()
+	if len(errs) > 0 && len(ports) == 0 {
+		return nil, joinErrors(errs)
+	}
+	for _, err := range errs {
+		logrus.WithError(err).Warn("Listing ports")
+	}
+	return ports, nil
+}
+
+// WatchListBoards starts all the available discoveries in sync mode and
+// sends an event on the returned channel every time a port is connected or
+// disconnected. The discoveries are terminated and the channel is closed
+// when the context is cancelled.
+func WatchListBoards(ctx context.Context, pm *packagemanager.PackageManager) (<-chan *BoardPortEvent, error) {
+	dm := GetDiscoveryManager(pm)
+	events, errs := dm.Watch(ctx)
+	if events == nil {
+		return nil, joinErrors(errs)
+	}
+	for _, err := range errs {
+		logrus.WithError(err).Warn("Watching ports")
+	}
+	return events, nil
+}
+
+// ParsePortAddress splits a port specified as PROTOCOL://ADDRESS in its
+// protocol and address parts. If the protocol is missing the port is
+// assumed to be a serial port.
+func ParsePortAddress(port string) (protocol string, address string) {
+	if split := strings.SplitN(port, "://", 2); len(split) == 2 {
+		return split[0], split[1]
+	}
+	return "serial", port
+}
+
+func joinErrors(errs []error) error {
+	if len(errs) == 0 {
+		return errors.New("no discovery available")
+	}
+	msgs := []string{}
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
+	}
+	return errors.New(strings.Join(msgs, "; "))
+}
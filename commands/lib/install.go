@@ -29,7 +29,8 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// LibraryInstall FIXMEDOC
+// LibraryInstall installs a library together with all its dependencies,
+// unless NoDeps is set in the request.
 func LibraryInstall(ctx context.Context, req *rpc.LibraryInstallReq,
 	downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB, downloaderHeaders http.Header) error {
 
@@ -40,12 +41,24 @@ func LibraryInstall(ctx context.Context, req *rpc.LibraryInstallReq,
 		return fmt.Errorf("looking for library: %s", err)
 	}
 
-	if err := downloadLibrary(lm, libRelease, downloadCB, taskCB, downloaderHeaders); err != nil {
-		return fmt.Errorf("downloading library: %s", err)
+	toInstall := []*librariesindex.Release{libRelease}
+	if !req.GetNoDeps() {
+		toInstall, err = resolveLibraryDependencies(lm, libRelease)
+		if err != nil {
+			return fmt.Errorf("resolving dependencies: %s", err)
+		}
 	}
 
-	if err := installLibrary(lm, libRelease, taskCB); err != nil {
-		return err
+	for _, lib := range toInstall {
+		if err := downloadLibrary(lm, lib, downloadCB, taskCB, downloaderHeaders); err != nil {
+			return fmt.Errorf("downloading library: %s", err)
+		}
+	}
+
+	for _, lib := range toInstall {
+		if err := installLibrary(lm, lib, taskCB); err != nil {
+			return err
+		}
 	}
 
 	if _, err := commands.Rescan(req.GetInstance().GetId()); err != nil {
@@ -135,6 +135,7 @@ func GetOutputRelease(lib *librariesindex.Release) *rpc.LibraryRelease { //
 			Category:      lib.Category,
 			Architectures: lib.Architectures,
 			Types:         lib.Types,
+			Dependencies:  getLibraryDependencies(lib),
 		}
 	}
 	return &rpc.LibraryRelease{}
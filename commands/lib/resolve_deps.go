@@ -0,0 +1,103 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package lib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// LibraryResolveDependencies returns the list of libraries that must be
+// installed together with the requested library, without installing them.
+func LibraryResolveDependencies(ctx context.Context, req *rpc.LibraryResolveDependenciesReq) (*rpc.LibraryResolveDependenciesResp, error) {
+	lm := commands.GetLibraryManager(req.GetInstance().GetId())
+	if lm == nil {
+		return nil, errors.New("invalid instance")
+	}
+
+	libRelease, err := findLibraryIndexRelease(lm, req)
+	if err != nil {
+		return nil, fmt.Errorf("looking for library: %s", err)
+	}
+
+	installed := installedSketchbookVersions(lm)
+	deps, err := lm.Index.ResolveDependencies(libRelease, installed)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*rpc.LibraryDependencyStatus{}
+	for _, dep := range deps {
+		installedVersion := ""
+		if version, have := installed[dep.Library.Name]; have {
+			installedVersion = version.String()
+		}
+		res = append(res, &rpc.LibraryDependencyStatus{
+			Name:             dep.Library.Name,
+			VersionRequired:  dep.Version.String(),
+			VersionInstalled: installedVersion,
+		})
+	}
+	return &rpc.LibraryResolveDependenciesResp{Dependencies: res}, nil
+}
+
+// resolveLibraryDependencies returns the releases that must be installed to
+// satisfy the requested release and all its dependencies. The dependencies
+// already installed in the sketchbook are filtered out.
+func resolveLibraryDependencies(lm *librariesmanager.LibrariesManager, libRelease *librariesindex.Release) ([]*librariesindex.Release, error) {
+	installed := installedSketchbookVersions(lm)
+	deps, err := lm.Index.ResolveDependencies(libRelease, installed)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*librariesindex.Release{}
+	for _, dep := range deps {
+		if dep == libRelease {
+			res = append(res, dep)
+			continue
+		}
+		if version, have := installed[dep.Library.Name]; have && version.Equal(dep.Version) {
+			continue
+		}
+		res = append(res, dep)
+	}
+	return res, nil
+}
+
+// installedSketchbookVersions returns the versions of the libraries installed
+// in the sketchbook, indexed by library name.
+func installedSketchbookVersions(lm *librariesmanager.LibrariesManager) map[string]*semver.Version {
+	res := map[string]*semver.Version{}
+	for name, alternatives := range lm.Libraries {
+		for _, lib := range alternatives.Alternatives {
+			if lib.Location == libraries.Sketchbook {
+				res[name] = lib.Version
+			}
+		}
+	}
+	return res
+}
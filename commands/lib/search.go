@@ -75,5 +75,17 @@ func GetLibraryParameters(rel *librariesindex.Release) *rpc.LibraryRelease {
 			Size:            rel.Resource.Size,
 			Cachepath:       rel.Resource.CachePath,
 		},
+		Dependencies: getLibraryDependencies(rel),
 	}
 }
+
+func getLibraryDependencies(rel *librariesindex.Release) []*rpc.LibraryDependency {
+	res := []*rpc.LibraryDependency{}
+	for _, dep := range rel.Dependencies {
+		res = append(res, &rpc.LibraryDependency{
+			Name:              dep.Name,
+			VersionConstraint: dep.VersionConstraint.String(),
+		})
+	}
+	return res
+}
@@ -24,3 +24,6 @@ type DownloadProgressCB func(curr *rpc.DownloadProgress)
 
 // TaskProgressCB is a callback to receive progress messages
 type TaskProgressCB func(msg *rpc.TaskProgress)
+
+// UploadProgressCB is a callback to receive the progress of an upload
+type UploadProgressCB func(progress *rpc.UploadProgress)
@@ -0,0 +1,89 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package upload
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/sirupsen/logrus"
+)
+
+// BurnBootloader burns the bootloader of the board using the specified
+// programmer: the "erase.pattern" recipe is run first (if defined) and then
+// the "bootloader.pattern" recipe.
+func BurnBootloader(ctx context.Context, req *rpc.BurnBootloaderReq, outStream io.Writer, errStream io.Writer) (*rpc.BurnBootloaderResp, error) {
+	logrus.Tracef("Burn bootloader on %s started", req.GetFqbn())
+
+	if req.GetFqbn() == "" {
+		return nil, fmt.Errorf("no Fully Qualified Board Name provided")
+	}
+	fqbn, err := cores.ParseFQBN(req.GetFqbn())
+	if err != nil {
+		return nil, fmt.Errorf("incorrect FQBN: %s", err)
+	}
+
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+
+	// Find target board and board properties
+	_, boardPlatform, board, boardProperties, buildPlatform, err := pm.ResolveFQBN(fqbn)
+	if err != nil {
+		return nil, fmt.Errorf("incorrect FQBN: %s", err)
+	}
+
+	if req.GetProgrammer() == "" {
+		return nil, fmt.Errorf("no programmer provided")
+	}
+	programmer := findProgrammer(req.GetProgrammer(), boardPlatform, buildPlatform)
+	if programmer == nil {
+		return nil, fmt.Errorf("programmer '%s' not found", req.GetProgrammer())
+	}
+
+	// Build configuration for bootloader
+	bootloaderProperties, err := getToolProperties(pm, board, boardProperties, programmer, "bootloader.tool")
+	if err != nil {
+		return nil, err
+	}
+
+	setActionVerbosity(bootloaderProperties, "erase", req.GetVerbose())
+	setActionVerbosity(bootloaderProperties, "bootloader", req.GetVerbose())
+	setActionVerify(bootloaderProperties, "erase", req.GetVerify())
+	setActionVerify(bootloaderProperties, "bootloader", req.GetVerify())
+
+	if port := req.GetPort(); port != "" {
+		setSerialPortProperties(bootloaderProperties, port)
+	}
+
+	if _, ok := bootloaderProperties.GetOk("erase.pattern"); ok {
+		if err := runTool(ctx, "erase.pattern", bootloaderProperties, outStream, errStream, func(*rpc.UploadProgress) {}); err != nil {
+			return nil, fmt.Errorf("erasing error: %s", err)
+		}
+	}
+
+	if err := runTool(ctx, "bootloader.pattern", bootloaderProperties, outStream, errStream, func(*rpc.UploadProgress) {}); err != nil {
+		return nil, fmt.Errorf("burning bootloader error: %s", err)
+	}
+
+	logrus.Tracef("Burn bootloader on %s successful", req.GetFqbn())
+
+	return &rpc.BurnBootloaderResp{}, nil
+}
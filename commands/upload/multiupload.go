@@ -0,0 +1,139 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package upload
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/golang/protobuf/proto"
+	"github.com/sirupsen/logrus"
+)
+
+// MultiUploadCB is a callback to receive the output, the progress and the
+// result of the uploads of a MultiUpload, each response refers to a port
+type MultiUploadCB func(resp *rpc.MultiUploadResp)
+
+// MultiUpload uploads the same binary on the boards connected to the given
+// ports, in parallel. The callback is never called concurrently. An error
+// is returned if the upload fails on any port, the error of each port is
+// reported in the last response for that port.
+func MultiUpload(ctx context.Context, req *rpc.MultiUploadReq, respCB MultiUploadCB) error {
+	if req.GetUpload() == nil {
+		return fmt.Errorf("missing upload parameters")
+	}
+	if req.GetUpload().GetReadbackFile() != "" {
+		// every port would read back into the same file
+		return fmt.Errorf("reading back the flash into a file is not supported when uploading on many ports")
+	}
+	ports := req.GetPorts()
+	if len(ports) == 0 {
+		return fmt.Errorf("no upload port provided")
+	}
+	seen := map[string]bool{}
+	for _, port := range ports {
+		if seen[port] {
+			return fmt.Errorf("port %s specified more than once", port)
+		}
+		seen[port] = true
+	}
+
+	if len(ports) > 1 {
+		// the boards are reset at the same time, their upload ports must
+		// not be mixed up
+		ctx = context.WithValue(ctx, concurrentUploadsKey{}, true)
+	}
+
+	var mux sync.Mutex
+	send := func(resp *rpc.MultiUploadResp) {
+		mux.Lock()
+		defer mux.Unlock()
+		respCB(resp)
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(ports))
+	for i, port := range ports {
+		wg.Add(1)
+		go func(i int, port string) {
+			defer wg.Done()
+			errs[i] = uploadOnPort(ctx, req.GetUpload(), port, send)
+		}(i, port)
+	}
+	wg.Wait()
+
+	failed := 0
+	for i, err := range errs {
+		if err != nil {
+			logrus.WithError(err).WithField("port", ports[i]).Error("Upload failed")
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("upload failed on %d of %d ports", failed, len(ports))
+	}
+	return nil
+}
+
+type concurrentUploadsKey struct{}
+
+// concurrentUploads returns true if other boards are uploaded at the same
+// time of the upload running with the given context
+func concurrentUploads(ctx context.Context) bool {
+	concurrent, _ := ctx.Value(concurrentUploadsKey{}).(bool)
+	return concurrent
+}
+
+func uploadOnPort(ctx context.Context, uploadReq *rpc.UploadReq, port string, send MultiUploadCB) error {
+	req := proto.Clone(uploadReq).(*rpc.UploadReq)
+	req.Port = port
+
+	outStream := &portStreamWriter{port: port, send: send}
+	errStream := &portStreamWriter{port: port, send: send, stderr: true}
+	resp, err := Upload(ctx, req, outStream, errStream, func(progress *rpc.UploadProgress) {
+		send(&rpc.MultiUploadResp{Port: port, Upload: &rpc.UploadResp{Progress: progress}})
+	})
+
+	res := &rpc.MultiUploadResp{Port: port, Upload: resp, Completed: true}
+	if err != nil {
+		res.Error = err.Error()
+	}
+	send(res)
+	return err
+}
+
+// portStreamWriter sends the output of the upload on a port as
+// MultiUploadResp
+type portStreamWriter struct {
+	port   string
+	send   MultiUploadCB
+	stderr bool
+}
+
+func (w *portStreamWriter) Write(data []byte) (int, error) {
+	// data may be reused by the caller after Write returns
+	buf := append([]byte{}, data...)
+	resp := &rpc.UploadResp{OutStream: buf}
+	if w.stderr {
+		resp = &rpc.UploadResp{ErrStream: buf}
+	}
+	w.send(&rpc.MultiUploadResp{Port: w.port, Upload: resp})
+	return len(data), nil
+}
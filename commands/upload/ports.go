@@ -0,0 +1,167 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package upload
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	serial "go.bug.st/serial.v1"
+	"go.bug.st/serial.v1/enumerator"
+)
+
+// getPortsList returns the serial ports connected to the machine with their
+// USB details. If the details are not available on the running OS only the
+// names of the ports are returned.
+var getPortsList = func() ([]*enumerator.PortDetails, error) {
+	if ports, err := enumerator.GetDetailedPortsList(); err == nil {
+		return ports, nil
+	}
+	names, err := serial.GetPortsList()
+	if err != nil {
+		return nil, err
+	}
+	ports := []*enumerator.PortDetails{}
+	for _, name := range names {
+		ports = append(ports, &enumerator.PortDetails{Name: name})
+	}
+	return ports, nil
+}
+
+// serialMatchTimeout is the time the upload port is searched by serial
+// number before falling back to the VID, when several boards are reset
+const serialMatchTimeout = 3 * time.Second
+
+// uploadPortWatcher looks for the port where a board appears after a reset
+// (usually the port of the bootloader). The board is recognized by the
+// serial number of its USB interface, so that several boards can be reset
+// at the same time without mixing up their ports. Many bootloaders have a
+// different or empty serial number: if no port with the same serial number
+// appears, the first new port with the same VID is taken (after some time,
+// when several boards are reset) or any new port when a single board is
+// reset. If the serial number is not available the first new port is
+// taken.
+type uploadPortWatcher struct {
+	port         string
+	serialNumber string
+	vid          string
+	// exclusive is true if no other board is reset at the same time
+	exclusive bool
+	// fallback enables the match by VID, when several boards are reset
+	fallback bool
+	// ports present before the reset, ignored until they disappear
+	known map[string]bool
+}
+
+// newUploadPortWatcher takes a snapshot of the ports connected to the
+// machine: it must be called before resetting the board.
+func newUploadPortWatcher(port string, exclusive bool) (*uploadPortWatcher, error) {
+	ports, err := getPortsList()
+	if err != nil {
+		return nil, fmt.Errorf("scanning serial ports: %s", err)
+	}
+	w := &uploadPortWatcher{port: port, exclusive: exclusive, known: map[string]bool{}}
+	for _, p := range ports {
+		w.known[p.Name] = true
+		if p.Name == port && p.IsUSB {
+			w.serialNumber = p.SerialNumber
+			w.vid = p.VID
+		}
+	}
+	return w, nil
+}
+
+// check updates the watcher with the ports currently connected and
+// returns the upload port, if detected, or an empty string
+func (w *uploadPortWatcher) check(ports []*enumerator.PortDetails) string {
+	present := map[string]bool{}
+	for _, p := range ports {
+		present[p.Name] = true
+	}
+	for name := range w.known {
+		if !present[name] {
+			delete(w.known, name)
+		}
+	}
+
+	first, sameVID := "", ""
+	for _, p := range ports {
+		if w.known[p.Name] {
+			continue
+		}
+		if w.serialNumber == "" || p.SerialNumber == w.serialNumber {
+			return p.Name
+		}
+		if first == "" {
+			first = p.Name
+		}
+		if sameVID == "" && w.vid != "" && strings.EqualFold(p.VID, w.vid) {
+			sameVID = p.Name
+		}
+	}
+	if w.exclusive && sameVID == "" {
+		return first
+	}
+	if w.exclusive || w.fallback {
+		return sameVID
+	}
+	return ""
+}
+
+// wait watches the ports connected to the machine until the upload port
+// appears or the timeout expires. An empty string is returned if the port
+// is not detected.
+func (w *uploadPortWatcher) wait(timeout time.Duration) (string, error) {
+	logrus.WithField("port", w.port).WithField("serial_number", w.serialNumber).Info("Waiting for upload port...")
+
+	start := time.Now()
+	deadline := start.Add(timeout)
+	for time.Now().Before(deadline) {
+		w.fallback = time.Since(start) > serialMatchTimeout
+		ports, err := getPortsList()
+		if err != nil {
+			return "", fmt.Errorf("scanning serial ports: %s", err)
+		}
+		if p := w.check(ports); p != "" {
+			return p, nil // Found it!
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+	return "", nil
+}
+
+// waitForPort waits until the given port is connected to the machine or
+// the timeout expires, returns true if the port is found
+func waitForPort(port string, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		ports, err := getPortsList()
+		if err != nil {
+			return false
+		}
+		for _, p := range ports {
+			if p.Name == port {
+				return true
+			}
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+	return false
+}
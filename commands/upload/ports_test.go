@@ -0,0 +1,116 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package upload
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/serial.v1/enumerator"
+)
+
+func TestUploadPortWatcher(t *testing.T) {
+	board1 := &enumerator.PortDetails{Name: "/dev/ttyACM0", IsUSB: true, VID: "2341", PID: "804e", SerialNumber: "AAAA"}
+	board2 := &enumerator.PortDetails{Name: "/dev/ttyACM1", IsUSB: true, VID: "2341", PID: "804e", SerialNumber: "BBBB"}
+	ports := []*enumerator.PortDetails{board1, board2}
+	defer func(f func() ([]*enumerator.PortDetails, error)) { getPortsList = f }(getPortsList)
+	getPortsList = func() ([]*enumerator.PortDetails, error) { return ports, nil }
+
+	w1, err := newUploadPortWatcher("/dev/ttyACM0", false)
+	require.NoError(t, err)
+	require.Equal(t, "AAAA", w1.serialNumber)
+	w2, err := newUploadPortWatcher("/dev/ttyACM1", false)
+	require.NoError(t, err)
+	require.Equal(t, "BBBB", w2.serialNumber)
+
+	// both boards reset, the bootloader of board2 appears first
+	require.Equal(t, "", w1.check([]*enumerator.PortDetails{}))
+	require.Equal(t, "", w2.check([]*enumerator.PortDetails{}))
+	boot2 := &enumerator.PortDetails{Name: "/dev/ttyACM0", IsUSB: true, VID: "2341", PID: "004e", SerialNumber: "BBBB"}
+	require.Equal(t, "", w1.check([]*enumerator.PortDetails{boot2}))
+	require.Equal(t, "/dev/ttyACM0", w2.check([]*enumerator.PortDetails{boot2}))
+	boot1 := &enumerator.PortDetails{Name: "/dev/ttyACM1", IsUSB: true, VID: "2341", PID: "004e", SerialNumber: "AAAA"}
+	require.Equal(t, "/dev/ttyACM1", w1.check([]*enumerator.PortDetails{boot2, boot1}))
+
+	// the bootloaders have a different serial number: the port with the same
+	// VID is taken after some time, when several boards are reset
+	ports = []*enumerator.PortDetails{board1, board2}
+	w1, err = newUploadPortWatcher("/dev/ttyACM0", false)
+	require.NoError(t, err)
+	require.Equal(t, "", w1.check([]*enumerator.PortDetails{board2}))
+	other := &enumerator.PortDetails{Name: "/dev/ttyUSB0", IsUSB: true, VID: "0403", PID: "6001", SerialNumber: "CCCC"}
+	caterina := &enumerator.PortDetails{Name: "/dev/ttyACM2", IsUSB: true, VID: "2341", PID: "0036"}
+	require.Equal(t, "", w1.check([]*enumerator.PortDetails{board2, other, caterina}))
+	w1.fallback = true
+	require.Equal(t, "/dev/ttyACM2", w1.check([]*enumerator.PortDetails{board2, other, caterina}))
+
+	// the first new port is taken when a single board is reset
+	w4, err := newUploadPortWatcher("/dev/ttyACM1", true)
+	require.NoError(t, err)
+	require.Equal(t, "", w4.check([]*enumerator.PortDetails{board1}))
+	require.Equal(t, "/dev/ttyUSB0", w4.check([]*enumerator.PortDetails{board1, other}))
+	require.Equal(t, "/dev/ttyACM2", w4.check([]*enumerator.PortDetails{board1, other, caterina}))
+	boot4 := &enumerator.PortDetails{Name: "/dev/ttyACM3", IsUSB: true, VID: "2341", PID: "004e", SerialNumber: "BBBB"}
+	require.Equal(t, "/dev/ttyACM3", w4.check([]*enumerator.PortDetails{board1, other, caterina, boot4}))
+
+	// without serial number the first new port is taken
+	ports = []*enumerator.PortDetails{{Name: "COM3"}}
+	w3, err := newUploadPortWatcher("COM3", false)
+	require.NoError(t, err)
+	require.Equal(t, "", w3.check([]*enumerator.PortDetails{{Name: "COM3"}}))
+	require.Equal(t, "COM4", w3.check([]*enumerator.PortDetails{{Name: "COM3"}, {Name: "COM4"}}))
+}
+
+func TestWaitForPort(t *testing.T) {
+	defer func(f func() ([]*enumerator.PortDetails, error)) { getPortsList = f }(getPortsList)
+	scans := 0
+	getPortsList = func() ([]*enumerator.PortDetails, error) {
+		scans++
+		if scans < 3 {
+			return []*enumerator.PortDetails{}, nil
+		}
+		return []*enumerator.PortDetails{{Name: "/dev/ttyACM0"}}, nil
+	}
+	require.True(t, waitForPort("/dev/ttyACM0", 5*time.Second))
+	require.Equal(t, 3, scans)
+	require.False(t, waitForPort("/dev/ttyACM1", 300*time.Millisecond))
+}
+
+func TestMultiUploadErrors(t *testing.T) {
+	send := func(*rpc.MultiUploadResp) {}
+	require.Error(t, MultiUpload(context.Background(), &rpc.MultiUploadReq{Ports: []string{"COM1"}}, send))
+	require.Error(t, MultiUpload(context.Background(), &rpc.MultiUploadReq{Upload: &rpc.UploadReq{}}, send))
+	require.Error(t, MultiUpload(context.Background(), &rpc.MultiUploadReq{Upload: &rpc.UploadReq{}, Ports: []string{"COM1", "COM1"}}, send))
+	require.Error(t, MultiUpload(context.Background(), &rpc.MultiUploadReq{Upload: &rpc.UploadReq{ReadbackFile: "flash.bin"}, Ports: []string{"COM1", "COM2"}}, send))
+
+	// the failure of each port is reported in its last response
+	results := map[string]string{}
+	err := MultiUpload(context.Background(), &rpc.MultiUploadReq{Upload: &rpc.UploadReq{}, Ports: []string{"COM1", "COM2"}},
+		func(resp *rpc.MultiUploadResp) {
+			if resp.GetCompleted() {
+				results[resp.GetPort()] = resp.GetError()
+			}
+		})
+	require.EqualError(t, err, "upload failed on 2 of 2 ports")
+	require.Len(t, results, 2)
+	require.Equal(t, "missing sketchPath", results["COM1"])
+	require.Equal(t, "missing sketchPath", results["COM2"])
+}
@@ -0,0 +1,159 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package upload
+
+import (
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+)
+
+// progressParser is an io.Writer that forwards the output of an upload tool
+// to the underlying writer and, meanwhile, looks for the progress reports of
+// the well-known tools, sending a TOOL_PROGRESS event each time the
+// percentage of completion changes. The output is parsed line by line, both
+// '\n' and '\r' are considered line terminators.
+type progressParser struct {
+	out        io.Writer
+	progressCB commands.UploadProgressCB
+
+	line      []byte
+	operation string
+	percent   float32
+
+	// avrdude draws a bar of 50 '#', one at a time, after "Writing | "
+	avrdudeBar    bool
+	avrdudeHashes int
+}
+
+var (
+	// avrdude: "Writing | ################################################## | 100% 0.39s"
+	avrdudeStartRe = regexp.MustCompile(`^(Reading|Writing|Erasing) \| $`)
+
+	// bossac: "Write 14552 bytes to flash (57 pages)", "Verify 14552 bytes of flash"
+	//         "[==============================] 100% (57/57 pages)"
+	bossacOperationRe = regexp.MustCompile(`^(Erase|Write|Read|Verify) .*\bflash\b`)
+	bossacProgressRe  = regexp.MustCompile(`\] +(\d+)% \(\d+/\d+ pages\)`)
+
+	// esptool: "Writing at 0x00010000... (12 %)", "Erasing flash..."
+	//          "Writing at 0x0001a000 [====>     ]  28.6% 16384/57344 bytes..."
+	esptoolOperationRe = regexp.MustCompile(`^(Erasing|Writing|Reading|Verifying)( at 0x[0-9a-fA-F]+| flash)`)
+	esptoolProgressRe  = regexp.MustCompile(`\((\d+) %\)|(\d+(?:\.\d+)?)% \d+/\d+ bytes`)
+)
+
+var operationNames = map[string]string{
+	"Erase":     "erasing",
+	"Erasing":   "erasing",
+	"Write":     "writing",
+	"Writing":   "writing",
+	"Read":      "reading",
+	"Reading":   "reading",
+	"Verify":    "verifying",
+	"Verifying": "verifying",
+}
+
+func newProgressParser(out io.Writer, progressCB commands.UploadProgressCB) *progressParser {
+	return &progressParser{out: out, progressCB: progressCB, percent: -1}
+}
+
+func (p *progressParser) Write(data []byte) (int, error) {
+	for _, c := range data {
+		if c == '\n' || c == '\r' {
+			p.parseLine(string(p.line))
+			p.line = p.line[:0]
+			p.avrdudeBar = false
+			continue
+		}
+		p.line = append(p.line, c)
+		if p.avrdudeBar {
+			if c == '#' {
+				if p.avrdudeHashes < 50 {
+					p.avrdudeHashes++
+				}
+				p.report(p.operation, float32(p.avrdudeHashes*2))
+			}
+		} else if c == ' ' {
+			if m := avrdudeStartRe.FindSubmatch(p.line); m != nil {
+				p.avrdudeBar = true
+				p.avrdudeHashes = 0
+				p.report(operationNames[string(m[1])], 0)
+			}
+		}
+	}
+	return p.out.Write(data)
+}
+
+// parseLine looks for the progress reports of bossac and esptool, that are
+// printed on a single line
+func (p *progressParser) parseLine(line string) {
+	line = strings.TrimSpace(line)
+	if m := bossacOperationRe.FindStringSubmatch(line); m != nil {
+		p.operation = operationNames[m[1]]
+		p.percent = -1
+	} else if m := esptoolOperationRe.FindStringSubmatch(line); m != nil {
+		if operation := operationNames[m[1]]; operation != p.operation {
+			p.operation = operation
+			p.percent = -1
+		}
+	}
+	if p.operation == "" {
+		return
+	}
+	if m := bossacProgressRe.FindStringSubmatch(line); m != nil {
+		p.reportString(p.operation, m[1])
+	} else if m := esptoolProgressRe.FindStringSubmatch(line); m != nil {
+		p.reportString(p.operation, m[1]+m[2])
+	}
+}
+
+func (p *progressParser) reportString(operation string, percent string) {
+	if value, err := strconv.ParseFloat(percent, 32); err == nil {
+		p.report(operation, float32(value))
+	}
+}
+
+func (p *progressParser) report(operation string, percent float32) {
+	if operation == p.operation && percent == p.percent {
+		return
+	}
+	p.operation = operation
+	p.percent = percent
+	p.progressCB(&rpc.UploadProgress{
+		Phase:     rpc.UploadProgress_TOOL_PROGRESS,
+		Message:   strings.ToUpper(operation[:1]) + operation[1:] + " " + strconv.FormatFloat(float64(percent), 'f', -1, 32) + "%",
+		Operation: operation,
+		Percent:   percent,
+		Completed: percent >= 100,
+	})
+}
+
+// syncProgressCB makes the callback safe to be called from the goroutines
+// that copy the stdout and stderr of the tool
+func syncProgressCB(progressCB commands.UploadProgressCB) commands.UploadProgressCB {
+	var mux sync.Mutex
+	return func(progress *rpc.UploadProgress) {
+		mux.Lock()
+		defer mux.Unlock()
+		progressCB(progress)
+	}
+}
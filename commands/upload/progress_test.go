@@ -0,0 +1,86 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package upload
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/stretchr/testify/require"
+)
+
+func parseProgress(t *testing.T, chunks ...string) []string {
+	res := []string{}
+	out := &bytes.Buffer{}
+	parser := newProgressParser(out, func(p *rpc.UploadProgress) {
+		require.Equal(t, rpc.UploadProgress_TOOL_PROGRESS, p.Phase)
+		res = append(res, fmt.Sprintf("%s %g", p.Operation, p.Percent))
+	})
+	for _, chunk := range chunks {
+		n, err := parser.Write([]byte(chunk))
+		require.NoError(t, err)
+		require.Equal(t, len(chunk), n)
+	}
+	// the output is forwarded unchanged
+	require.Equal(t, strings.Join(chunks, ""), out.String())
+	return res
+}
+
+func TestProgressParserAvrdude(t *testing.T) {
+	chunks := []string{"avrdude: writing flash (924 bytes):\n\n", "Writing | "}
+	for i := 0; i < 50; i++ {
+		chunks = append(chunks, "#")
+	}
+	chunks = append(chunks, " | 100% 0.15s\n\n", "Reading | ", "#########################", "#########################", " | 100% 0.12s\n")
+	res := parseProgress(t, chunks...)
+	// every '#' is a 2% step, even when received in a single chunk
+	require.Len(t, res, 51*2)
+	require.Equal(t, "writing 0", res[0])
+	require.Equal(t, "writing 2", res[1])
+	require.Equal(t, "writing 100", res[50])
+	require.Equal(t, "reading 0", res[51])
+	require.Equal(t, "reading 100", res[101])
+}
+
+func TestProgressParserBossac(t *testing.T) {
+	res := parseProgress(t,
+		"Erase flash\n",
+		"Done in 0.5 seconds\n",
+		"Write 14552 bytes to flash (57 pages)\n",
+		"\r[                              ] 0% (0/57 pages)",
+		"\r[===============               ] 50% (29/57 pages)",
+		"\r[==============================] 100% (57/57 pages)\n",
+		"Verify 14552 bytes of flash\n",
+		"\r[==============================] 100% (57/57 pages)\n",
+		"Verify successful\n")
+	require.Equal(t, []string{"writing 0", "writing 50", "writing 100", "verifying 100"}, res)
+}
+
+func TestProgressParserEsptool(t *testing.T) {
+	res := parseProgress(t,
+		"Erasing flash (this may take a while)...\n",
+		"Writing at 0x00010000... (12 %)\n",
+		"Writing at 0x00014000... (50 %)\n",
+		"Writing at 0x00018000... (100 %)\n",
+		"Writing at 0x0001a000 [====>          ]  28.6% 16384/57344 bytes...\r",
+		"Hash of data verified.\n")
+	require.Equal(t, []string{"writing 12", "writing 50", "writing 100", "writing 28.6"}, res)
+}
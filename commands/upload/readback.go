@@ -0,0 +1,253 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package upload
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+)
+
+// avrdudeWriteFlashRe matches the flash write operation of the avrdude
+// command lines, e.g.: "-Uflash:w:{build.path}/{build.project_name}.hex:i"
+var avrdudeWriteFlashRe = regexp.MustCompile(`-Uflash:w:[^"' ]+`)
+
+// bossacWriteRe matches the erase, write and verify operations of the
+// bossac command lines, e.g.: `-e -w -v "{build.path}/{build.project_name}.bin"`
+var bossacWriteRe = regexp.MustCompile(`(\s-e)?\s-w(\s+(-v|\{upload\.verify\}))?\s+"?\{build\.path\}/\{build\.project_name\}\.bin"?`)
+
+// esptoolWriteFlashRe matches the write_flash command of the esptool
+// command lines and captures the address of the sketch binary, e.g.:
+// `write_flash -z ... 0x10000 "{build.path}/{build.project_name}.bin" ...`
+var esptoolWriteFlashRe = regexp.MustCompile(`write_flash\s.*?(0x[0-9a-fA-F]+)\s+"?\{build\.path\}/\{build\.project_name\}\.bin"?.*`)
+
+// setReadbackRecipe ensures that the ACTION.readback.pattern recipe, used
+// to read the flash of the board into the readback.path file, is defined
+// and returns its key. If the platform doesn't provide the recipe, it's
+// derived from the ACTION.pattern recipe of avrdude, bossac or esptool.
+// The esptool recipe reads readback.size bytes, by default the maximum
+// size of the sketch.
+func setReadbackRecipe(props *properties.Map, action string) (string, error) {
+	recipeID := action + ".readback.pattern"
+	if props.ContainsKey(recipeID) {
+		return recipeID, nil
+	}
+	pattern := props.Get(action + ".pattern")
+	hexFile := strings.HasSuffix(props.Get("readback.path"), ".hex")
+	switch {
+	case avrdudeWriteFlashRe.MatchString(pattern):
+		format := "r" // raw binary
+		if hexFile {
+			format = "i" // Intel HEX
+		}
+		props.Set(recipeID, avrdudeWriteFlashRe.ReplaceAllLiteralString(pattern, "-Uflash:r:{readback.path}:"+format))
+	case bossacWriteRe.MatchString(pattern):
+		if hexFile {
+			return "", fmt.Errorf("the flash of the board can be read back only into a binary file")
+		}
+		props.Set(recipeID, bossacWriteRe.ReplaceAllLiteralString(pattern, ` -r "{readback.path}"`))
+	case esptoolWriteFlashRe.MatchString(pattern):
+		if hexFile {
+			return "", fmt.Errorf("the flash of the board can be read back only into a binary file")
+		}
+		if !props.ContainsKey("readback.size") {
+			props.Set("readback.size", "{upload.maximum_size}")
+		}
+		props.Set(recipeID, esptoolWriteFlashRe.ReplaceAllString(pattern, `read_flash ${1} {readback.size} "{readback.path}"`))
+	default:
+		return "", fmt.Errorf("the board doesn't support flash readback: '%s' not defined", recipeID)
+	}
+	return recipeID, nil
+}
+
+// readFlash reads the flash of the board into the given file running the
+// readback recipe
+func readFlash(ctx context.Context, action string, props *properties.Map, file *paths.Path,
+	outStream io.Writer, errStream io.Writer, progressCB commands.UploadProgressCB) error {
+	props.SetPath("readback.path", file)
+	recipeID, err := setReadbackRecipe(props, action)
+	if err != nil {
+		return err
+	}
+	return runTool(ctx, recipeID, props, outStream, errStream, progressCB)
+}
+
+// verifyFlash reads back the flash of the board and compares it with the
+// uploaded file. A *FlashMismatchError is returned if they differ.
+func verifyFlash(ctx context.Context, action string, props *properties.Map, uploadedFile *paths.Path,
+	outStream io.Writer, errStream io.Writer, progressCB commands.UploadProgressCB) error {
+	expected, err := loadFlashImage(uploadedFile)
+	if err != nil {
+		return fmt.Errorf("loading uploaded binary: %s", err)
+	}
+
+	tmp, err := paths.MkTempDir("", "arduino-readback")
+	if err != nil {
+		return fmt.Errorf("creating temp dir for readback: %s", err)
+	}
+	defer tmp.RemoveAll()
+	readbackFile := tmp.Join("readback" + uploadedFile.Ext())
+	props.Set("readback.size", fmt.Sprintf("%d", expected.size()))
+
+	progressCB(&rpc.UploadProgress{Phase: rpc.UploadProgress_VERIFY, Message: "Reading back flash"})
+	if err := readFlash(ctx, action, props, readbackFile, outStream, errStream, progressCB); err == ErrUploadCancelled || err == ErrUploadTimeout {
+		return err
+	} else if err != nil {
+		return fmt.Errorf("readback error: %s", err)
+	}
+	actual, err := loadFlashImage(readbackFile)
+	if err != nil {
+		return fmt.Errorf("loading readback: %s", err)
+	}
+	if mismatch := compareFlashImages(expected, actual); mismatch != nil {
+		progressCB(&rpc.UploadProgress{Phase: rpc.UploadProgress_VERIFY, Message: mismatch.Error(), Completed: true})
+		return mismatch
+	}
+	progressCB(&rpc.UploadProgress{Phase: rpc.UploadProgress_VERIFY, Message: "Flash verified", Completed: true})
+	return nil
+}
+
+// flashImage is the content of a firmware image, indexed by address
+type flashImage map[uint32]byte
+
+// size returns the size of the image, from address 0 to the last byte
+func (image flashImage) size() uint32 {
+	size := uint32(0)
+	for address := range image {
+		if address >= size {
+			size = address + 1
+		}
+	}
+	return size
+}
+
+// loadFlashImage loads an Intel HEX file (.hex) or a raw binary file,
+// the binary files are loaded starting from address 0
+func loadFlashImage(file *paths.Path) (flashImage, error) {
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	if file.Ext() != ".hex" {
+		image := flashImage{}
+		for i, b := range data {
+			image[uint32(i)] = b
+		}
+		return image, nil
+	}
+	return parseIntelHex(bytes.NewReader(data))
+}
+
+func parseIntelHex(in io.Reader) (flashImage, error) {
+	image := flashImage{}
+	base := uint32(0)
+	scanner := bufio.NewScanner(in)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if !strings.HasPrefix(line, ":") {
+			return nil, fmt.Errorf("invalid Intel HEX record at line %d", n)
+		}
+		record, err := hex.DecodeString(line[1:])
+		if err != nil || len(record) < 5 || len(record) != int(record[0])+5 {
+			return nil, fmt.Errorf("invalid Intel HEX record at line %d", n)
+		}
+		checksum := byte(0)
+		for _, b := range record {
+			checksum += b
+		}
+		if checksum != 0 {
+			return nil, fmt.Errorf("invalid checksum at line %d", n)
+		}
+
+		address := uint32(record[1])<<8 | uint32(record[2])
+		data := record[4 : len(record)-1]
+		switch record[3] {
+		case 0x00: // data
+			for i, b := range data {
+				image[base+address+uint32(i)] = b
+			}
+		case 0x01: // end of file
+			return image, nil
+		case 0x02: // extended segment address
+			if len(data) != 2 {
+				return nil, fmt.Errorf("invalid Intel HEX record at line %d", n)
+			}
+			base = (uint32(data[0])<<8 | uint32(data[1])) << 4
+		case 0x04: // extended linear address
+			if len(data) != 2 {
+				return nil, fmt.Errorf("invalid Intel HEX record at line %d", n)
+			}
+			base = (uint32(data[0])<<8 | uint32(data[1])) << 16
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return image, nil
+}
+
+// FlashMismatchError is returned when the flash read back from the board
+// differs from the uploaded binary, it describes the first difference
+type FlashMismatchError struct {
+	Address  uint32
+	Expected byte
+	Actual   byte
+	Missing  bool
+}
+
+func (m *FlashMismatchError) Error() string {
+	if m.Missing {
+		return fmt.Sprintf("verification failed at address 0x%08X: expected 0x%02X, not read back", m.Address, m.Expected)
+	}
+	return fmt.Sprintf("verification failed at address 0x%08X: expected 0x%02X, read 0x%02X", m.Address, m.Expected, m.Actual)
+}
+
+// compareFlashImages checks that actual contains all the bytes of
+// expected (actual may contain more data, like the content of the whole
+// flash) and returns the first mismatch or nil
+func compareFlashImages(expected, actual flashImage) *FlashMismatchError {
+	addresses := []uint32{}
+	for address := range expected {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool { return addresses[i] < addresses[j] })
+	for _, address := range addresses {
+		b, ok := actual[address]
+		if !ok {
+			return &FlashMismatchError{Address: address, Expected: expected[address], Missing: true}
+		}
+		if b != expected[address] {
+			return &FlashMismatchError{Address: address, Expected: expected[address], Actual: b}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package upload
+
+import (
+	"strings"
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIntelHex(t *testing.T) {
+	image, err := parseIntelHex(strings.NewReader(`
+:0400000001020304F2
+:020000040001F9
+:02000000AABB99
+:00000001FF
+`))
+	require.NoError(t, err)
+	require.Equal(t, flashImage{0: 1, 1: 2, 2: 3, 3: 4, 0x10000: 0xAA, 0x10001: 0xBB}, image)
+
+	_, err = parseIntelHex(strings.NewReader(":0400000001020304F3\n"))
+	require.EqualError(t, err, "invalid checksum at line 1")
+	_, err = parseIntelHex(strings.NewReader("0400000001020304F2\n"))
+	require.Error(t, err)
+	_, err = parseIntelHex(strings.NewReader(":05000000010203F2\n"))
+	require.Error(t, err)
+}
+
+func TestCompareFlashImages(t *testing.T) {
+	expected := flashImage{0: 1, 1: 2, 2: 3}
+	require.Nil(t, compareFlashImages(expected, flashImage{0: 1, 1: 2, 2: 3, 3: 0xFF}))
+
+	mismatch := compareFlashImages(expected, flashImage{0: 1, 1: 5, 2: 6})
+	require.NotNil(t, mismatch)
+	require.Equal(t, uint32(1), mismatch.Address)
+	require.EqualError(t, mismatch, "verification failed at address 0x00000001: expected 0x02, read 0x05")
+
+	mismatch = compareFlashImages(expected, flashImage{0: 1, 1: 2})
+	require.NotNil(t, mismatch)
+	require.True(t, mismatch.Missing)
+	require.Equal(t, uint32(2), mismatch.Address)
+}
+
+func TestLoadFlashImage(t *testing.T) {
+	dir, err := paths.MkTempDir("", "readback-test")
+	require.NoError(t, err)
+	defer dir.RemoveAll()
+
+	require.NoError(t, dir.Join("firmware.bin").WriteFile([]byte{1, 2, 3, 4}))
+	require.NoError(t, dir.Join("firmware.hex").WriteFile([]byte(":0400000001020304F2\n:00000001FF\n")))
+	bin, err := loadFlashImage(dir.Join("firmware.bin"))
+	require.NoError(t, err)
+	hex, err := loadFlashImage(dir.Join("firmware.hex"))
+	require.NoError(t, err)
+	require.Equal(t, bin, hex)
+}
+
+func TestSetReadbackRecipe(t *testing.T) {
+	props := properties.NewMap()
+	props.Set("upload.pattern", `"{cmd.path}" -P{serial.port} -D "-Uflash:w:{build.path}/{build.project_name}.hex:i"`)
+	props.Set("readback.path", "/tmp/out.hex")
+	recipeID, err := setReadbackRecipe(props, "upload")
+	require.NoError(t, err)
+	require.Equal(t, "upload.readback.pattern", recipeID)
+	require.Equal(t, `"{cmd.path}" -P{serial.port} -D "-Uflash:r:{readback.path}:i"`, props.Get(recipeID))
+
+	// the recipe of the platform is preferred
+	props.Set("program.readback.pattern", "custom")
+	recipeID, err = setReadbackRecipe(props, "program")
+	require.NoError(t, err)
+	require.Equal(t, "custom", props.Get(recipeID))
+
+	props = properties.NewMap()
+	props.Set("upload.pattern", `"{cmd.path}" --port={serial.port.file} -w "{build.path}/{build.project_name}.elf"`)
+	_, err = setReadbackRecipe(props, "upload")
+	require.Error(t, err)
+
+	// bossac
+	props = properties.NewMap()
+	props.Set("upload.pattern", `"{path}/{cmd}" {upload.verbose} --port={serial.port.file} -U {upload.native_usb} -i -e -w -v "{build.path}/{build.project_name}.bin" -R`)
+	recipeID, err = setReadbackRecipe(props, "upload")
+	require.NoError(t, err)
+	require.Equal(t, `"{path}/{cmd}" {upload.verbose} --port={serial.port.file} -U {upload.native_usb} -i -r "{readback.path}" -R`, props.Get(recipeID))
+	props = properties.NewMap()
+	props.Set("upload.pattern", `"{path}/{cmd}" {upload.verbose} --port={serial.port.file} -U -i --offset={upload.offset} -w {upload.verify} "{build.path}/{build.project_name}.bin" -R`)
+	recipeID, err = setReadbackRecipe(props, "upload")
+	require.NoError(t, err)
+	require.Equal(t, `"{path}/{cmd}" {upload.verbose} --port={serial.port.file} -U -i --offset={upload.offset} -r "{readback.path}" -R`, props.Get(recipeID))
+	props.Set("readback.path", "/tmp/out.hex")
+	props.Remove(recipeID)
+	_, err = setReadbackRecipe(props, "upload")
+	require.Error(t, err)
+
+	// esptool
+	props = properties.NewMap()
+	props.Set("upload.pattern", `"{path}/{cmd}" --chip esp32 --port "{serial.port}" --baud {upload.speed} --before default_reset --after hard_reset write_flash -z --flash_mode {build.flash_mode} 0xe000 "{runtime.platform.path}/tools/partitions/boot_app0.bin" 0x10000 "{build.path}/{build.project_name}.bin" 0x8000 "{build.path}/{build.project_name}.partitions.bin"`)
+	recipeID, err = setReadbackRecipe(props, "upload")
+	require.NoError(t, err)
+	require.Equal(t, `"{path}/{cmd}" --chip esp32 --port "{serial.port}" --baud {upload.speed} --before default_reset --after hard_reset read_flash 0x10000 {readback.size} "{readback.path}"`, props.Get(recipeID))
+	require.Equal(t, "{upload.maximum_size}", props.Get("readback.size"))
+}
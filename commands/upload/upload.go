@@ -19,14 +19,18 @@ package upload
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/arduino/sketches"
 	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/commands"
@@ -38,30 +42,50 @@ import (
 	serial "go.bug.st/serial.v1"
 )
 
+var (
+	// ErrUploadCancelled is returned when the upload is cancelled before
+	// the completion of the upload tool, that is killed
+	ErrUploadCancelled = errors.New("upload cancelled")
+
+	// ErrUploadTimeout is returned when the upload tool doesn't complete
+	// within the upload timeout (or the deadline of the request), the tool
+	// is killed
+	ErrUploadTimeout = errors.New("upload timed out")
+)
+
 // Upload FIXMEDOC
-func Upload(ctx context.Context, req *rpc.UploadReq, outStream io.Writer, errStream io.Writer) (*rpc.UploadResp, error) {
+func Upload(ctx context.Context, req *rpc.UploadReq, outStream io.Writer, errStream io.Writer, progressCB commands.UploadProgressCB) (*rpc.UploadResp, error) {
 	logrus.Tracef("Upload %s on %s started", req.GetSketchPath(), req.GetFqbn())
 
-	// TODO: make a generic function to extract sketch from request
-	// and remove duplication in commands/compile.go
-	if req.GetSketchPath() == "" {
+	// the progress may be reported concurrently by the parsers of the
+	// stdout and stderr of the upload tool
+	progressCB = syncProgressCB(progressCB)
+
+	// The sketch is optional if the binary to upload is given explicitly
+	var sketch *sketches.Sketch
+	if req.GetSketchPath() != "" {
+		var err error
+		sketch, err = sketches.NewSketchFromPath(paths.New(req.GetSketchPath()))
+		if err != nil {
+			return nil, fmt.Errorf("opening sketch: %s", err)
+		}
+	} else if req.GetImportFile() == "" && req.GetImportDir() == "" && req.GetReadbackFile() == "" {
 		return nil, fmt.Errorf("missing sketchPath")
 	}
-	sketchPath := paths.New(req.GetSketchPath())
-	sketch, err := sketches.NewSketchFromPath(sketchPath)
-	if err != nil {
-		return nil, fmt.Errorf("opening sketch: %s", err)
-	}
 
-	// FIXME: make a specification on how a port is specified via command line
-	port := req.GetPort()
-	if port == "" {
-		return nil, fmt.Errorf("no upload port provided")
+	// When reading back the flash into a file nothing is uploaded
+	readbackOnly := req.GetReadbackFile() != ""
+	if readbackOnly && req.GetReadbackVerify() {
+		return nil, fmt.Errorf("readback verification is not available when reading back the flash into a file")
 	}
 
 	fqbnIn := req.GetFqbn()
-	if fqbnIn == "" && sketch != nil && sketch.Metadata != nil {
-		fqbnIn = sketch.Metadata.CPU.Fqbn
+	if fqbnIn == "" && sketch != nil {
+		profileFqbn, err := sketch.Metadata.Fqbn(req.GetProfile())
+		if err != nil {
+			return nil, err
+		}
+		fqbnIn = profileFqbn
 	}
 	if fqbnIn == "" {
 		return nil, fmt.Errorf("no Fully Qualified Board Name provided")
@@ -74,119 +98,193 @@ func Upload(ctx context.Context, req *rpc.UploadReq, outStream io.Writer, errStr
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
 
 	// Find target board and board properties
-	_, _, board, boardProperties, _, err := pm.ResolveFQBN(fqbn)
+	_, boardPlatform, board, boardProperties, buildPlatform, err := pm.ResolveFQBN(fqbn)
 	if err != nil {
 		return nil, fmt.Errorf("incorrect FQBN: %s", err)
 	}
 
-	// Load programmer tool
-	uploadToolPattern, have := boardProperties.GetOk("upload.tool")
-	if !have || uploadToolPattern == "" {
-		return nil, fmt.Errorf("cannot get programmer tool: undefined 'upload.tool' property")
+	// Find the programmer, if requested: uploading via programmer uses the
+	// "program.*" recipes instead of the "upload.*" ones
+	action := "upload"
+	var programmer *properties.Map
+	if programmerID := req.GetProgrammer(); programmerID != "" {
+		programmer = findProgrammer(programmerID, boardPlatform, buildPlatform)
+		if programmer == nil {
+			return nil, fmt.Errorf("programmer '%s' not found", programmerID)
+		}
+		action = "program"
 	}
 
-	var referencedPlatformRelease *cores.PlatformRelease
-	if split := strings.Split(uploadToolPattern, ":"); len(split) > 2 {
-		return nil, fmt.Errorf("invalid 'upload.tool' property: %s", uploadToolPattern)
-	} else if len(split) == 2 {
-		referencedPackageName := split[0]
-		uploadToolPattern = split[1]
-		architecture := board.PlatformRelease.Platform.Architecture
+	// The port may be specified as PROTOCOL://ADDRESS, as reported by the
+	// discoveries, otherwise it's assumed to be a serial port
+	portProtocol, port := commands.ParsePortAddress(req.GetPort())
+	if port == "" && programmer == nil {
+		return nil, fmt.Errorf("no upload port provided")
+	}
+	if portProtocol == "serial" && isNetworkAddress(port) {
+		portProtocol = "network"
+	}
+	networkUpload := programmer == nil && portProtocol == "network"
+	if networkUpload && (readbackOnly || req.GetReadbackVerify()) {
+		return nil, fmt.Errorf("flash readback is not supported for network upload")
+	}
 
-		if referencedPackage := pm.Packages[referencedPackageName]; referencedPackage == nil {
-			return nil, fmt.Errorf("required platform %s:%s not installed", referencedPackageName, architecture)
-		} else if referencedPlatform := referencedPackage.Platforms[architecture]; referencedPlatform == nil {
-			return nil, fmt.Errorf("required platform %s:%s not installed", referencedPackageName, architecture)
-		} else {
-			referencedPlatformRelease = pm.GetInstalledPlatformRelease(referencedPlatform)
+	// Build configuration for upload
+	uploadProperties, err := getToolProperties(pm, board, boardProperties, programmer, action+".tool")
+	if err != nil {
+		return nil, err
+	}
+
+	// Set properties for verbose upload and verify
+	setActionVerbosity(uploadProperties, action, req.GetVerbose())
+	setActionVerify(uploadProperties, action, req.GetVerify())
+
+	// Set path to compiled binary
+	outputTmpFile, ok := uploadProperties.GetOk("recipe.output.tmp_file")
+	if !ok {
+		return nil, fmt.Errorf("property 'recipe.output.tmp_file' not defined")
+	}
+	outputTmpFile = uploadProperties.ExpandPropsInString(outputTmpFile)
+	ext := filepath.Ext(outputTmpFile)
+
+	var importPath *paths.Path
+	var importFile string
+	if !readbackOnly {
+		importPath, importFile, err = determineImportFile(req, sketch, fqbn, ext)
+		if err != nil {
+			return nil, err
 		}
+		uploadProperties.SetPath("build.path", importPath)
+		uploadProperties.Set("build.project_name", importFile)
 	}
 
-	// Build configuration for upload
-	uploadProperties := properties.NewMap()
-	if referencedPlatformRelease != nil {
-		uploadProperties.Merge(referencedPlatformRelease.Properties)
+	// Make sure the board supports flash readback before resetting and
+	// flashing it
+	if readbackOnly || req.GetReadbackVerify() {
+		readbackProperties := uploadProperties.Clone()
+		readbackProperties.Set("readback.path", req.GetReadbackFile())
+		if _, err := setReadbackRecipe(readbackProperties, action); err != nil {
+			return nil, err
+		}
 	}
-	uploadProperties.Merge(board.PlatformRelease.Properties)
-	uploadProperties.Merge(board.PlatformRelease.RuntimeProperties())
-	uploadProperties.Merge(boardProperties)
 
-	uploadToolProperties := uploadProperties.SubTree("tools." + uploadToolPattern)
-	uploadProperties.Merge(uploadToolProperties)
+	// Perform reset via 1200bps touch if requested (only for serial ports,
+	// not needed when uploading with a programmer and skipped on dry runs)
+	serialUpload := programmer == nil && portProtocol == "serial" && !req.GetDryRun()
 
-	if requiredTools, err := pm.FindToolsRequiredForBoard(board); err == nil {
-		for _, requiredTool := range requiredTools {
-			logrus.WithField("tool", requiredTool).Info("Tool required for upload")
-			uploadProperties.Merge(requiredTool.RuntimeProperties())
+	actualPort := port // default
+	if serialUpload {
+		if actualPort, err = resetBoard(ctx, port, uploadProperties, progressCB); err != nil {
+			return nil, err
 		}
 	}
 
-	// Set properties for verbose upload
-	Verbose := req.GetVerbose()
-	if Verbose {
-		if v, ok := uploadProperties.GetOk("upload.params.verbose"); ok {
-			uploadProperties.Set("upload.verbose", v)
+	// Set port properties
+	uploadProperties.Set("upload.port.protocol", portProtocol)
+	uploadProperties.Set("upload.port.address", actualPort)
+	setSerialPortProperties(uploadProperties, actualPort)
+
+	// Network upload uses a different recipe that receives the address of
+	// the board in serial.port and the credentials in network.*
+	recipeID := action + ".pattern"
+	if networkUpload {
+		recipeID = "upload.network_pattern"
+		if !uploadProperties.ContainsKey(recipeID) {
+			return nil, fmt.Errorf("the board doesn't support network upload: '%s' not defined", recipeID)
 		}
-	} else {
-		if v, ok := uploadProperties.GetOk("upload.params.quiet"); ok {
-			uploadProperties.Set("upload.verbose", v)
+		setNetworkPortProperties(uploadProperties, actualPort, req.GetNetworkPassword())
+	}
+	if readbackOnly {
+		readbackPath, err := paths.New(req.GetReadbackFile()).Abs()
+		if err != nil {
+			return nil, fmt.Errorf("invalid readback file: %s", err)
+		}
+		uploadProperties.SetPath("readback.path", readbackPath)
+		if recipeID, err = setReadbackRecipe(uploadProperties, action); err != nil {
+			return nil, err
 		}
 	}
 
-	// Set properties for verify
-	Verify := req.GetVerify()
-	if Verify {
-		uploadProperties.Set("upload.verify", uploadProperties.Get("upload.params.verify"))
-	} else {
-		uploadProperties.Set("upload.verify", uploadProperties.Get("upload.params.noverify"))
+	if req.GetDryRun() {
+		return dryRunResponse(recipeID, uploadProperties)
 	}
 
-	// Set path to compiled binary
-	// Make the filename without the FQBN configs part
-	fqbn.Configs = properties.NewMap()
-	fqbnSuffix := strings.Replace(fqbn.String(), ":", ".", -1)
+	if timeout := req.GetTimeoutMs(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Millisecond)
+		defer cancel()
+	}
 
-	var importPath *paths.Path
-	var importFile string
-	if req.GetImportFile() == "" {
-		importPath = sketch.FullPath
-		importFile = sketch.Name + "." + fqbnSuffix
-	} else {
-		importPath = paths.New(req.GetImportFile()).Parent()
-		importFile = paths.New(req.GetImportFile()).Base()
+	if req.GetParseProgress() {
+		outStream = newProgressParser(outStream, progressCB)
+		errStream = newProgressParser(errStream, progressCB)
 	}
 
-	outputTmpFile, ok := uploadProperties.GetOk("recipe.output.tmp_file")
-	outputTmpFile = uploadProperties.ExpandPropsInString(outputTmpFile)
-	if !ok {
-		return nil, fmt.Errorf("property 'recipe.output.tmp_file' not defined")
+	// Build recipe for upload and run tool
+	if err := runTool(ctx, recipeID, uploadProperties, outStream, errStream, progressCB); err == ErrUploadCancelled || err == ErrUploadTimeout {
+		return nil, err
+	} else if err != nil && readbackOnly {
+		return nil, fmt.Errorf("readback error: %s", err)
+	} else if err != nil {
+		return nil, fmt.Errorf("uploading error: %s", err)
 	}
-	ext := filepath.Ext(outputTmpFile)
-	if strings.HasSuffix(importFile, ext) {
-		importFile = importFile[:len(importFile)-len(ext)]
+
+	if readbackOnly {
+		logrus.Tracef("Readback from %s into %s successful", actualPort, req.GetReadbackFile())
+		return &rpc.UploadResp{}, nil
 	}
 
-	uploadProperties.SetPath("build.path", importPath)
-	uploadProperties.Set("build.project_name", importFile)
-	uploadFile := importPath.Join(importFile + ext)
-	if _, err := uploadFile.Stat(); err != nil {
-		if os.IsNotExist(err) {
-			return nil, fmt.Errorf("compiled sketch %s not found", uploadFile.String())
+	if req.GetReadbackVerify() {
+		// the upload tool starts the new sketch: the board must be reset
+		// again to read back the flash from the bootloader
+		if serialUpload && uploadProperties.GetBoolean("upload.use_1200bps_touch") {
+			if !waitForPort(port, 10*time.Second) {
+				return nil, fmt.Errorf("readback error: the board didn't reappear on %s after the upload", port)
+			}
+			readbackPort, err := resetBoard(ctx, port, uploadProperties, progressCB)
+			if err != nil {
+				return nil, err
+			}
+			uploadProperties.Set("upload.port.address", readbackPort)
+			setSerialPortProperties(uploadProperties, readbackPort)
+		}
+		uploadedFile := importPath.Join(importFile + ext)
+		if err := verifyFlash(ctx, action, uploadProperties, uploadedFile, outStream, errStream, progressCB); err != nil {
+			return nil, err
+		}
+	}
+
+	logrus.Tracef("Upload %s on %s successful", importFile, fqbnIn)
+
+	return &rpc.UploadResp{}, nil
+}
+
+// resetBoard performs the reset via 1200bps touch of the board on the
+// given serial port and waits for the upload port, if requested by the
+// board. The port where the board must be uploaded is returned.
+func resetBoard(ctx context.Context, port string, props *properties.Map, progressCB commands.UploadProgressCB) (string, error) {
+	// The ports must be scanned before the reset to recognize the port
+	// where the board appears after the reset
+	var portWatcher *uploadPortWatcher
+	var err error
+	if props.GetBoolean("upload.wait_for_upload_port") {
+		if portWatcher, err = newUploadPortWatcher(port, !concurrentUploads(ctx)); err != nil {
+			return "", fmt.Errorf("cannot detect serial ports: %s", err)
 		}
-		return nil, fmt.Errorf("cannot open sketch: %s", err)
 	}
 
-	// Perform reset via 1200bps touch if requested
-	if uploadProperties.GetBoolean("upload.use_1200bps_touch") {
+	if props.GetBoolean("upload.use_1200bps_touch") {
 		ports, err := serial.GetPortsList()
 		if err != nil {
-			return nil, fmt.Errorf("cannot get serial port list: %s", err)
+			return "", fmt.Errorf("cannot get serial port list: %s", err)
 		}
 		for _, p := range ports {
 			if p == port {
+				progressCB(&rpc.UploadProgress{Phase: rpc.UploadProgress_RESET, Message: "Resetting board on " + p})
 				if err := touchSerialPortAt1200bps(p); err != nil {
-					return nil, fmt.Errorf("cannot perform reset: %s", err)
+					return "", fmt.Errorf("cannot perform reset: %s", err)
 				}
+				progressCB(&rpc.UploadProgress{Phase: rpc.UploadProgress_RESET, Message: "Board reset", Completed: true})
 				break
 			}
 		}
@@ -199,14 +297,17 @@ func Upload(ctx context.Context, req *rpc.UploadReq, outStream io.Writer, errStr
 	}
 
 	// Wait for upload port if requested
-	actualPort := port // default
-	if uploadProperties.GetBoolean("upload.wait_for_upload_port") {
-		if p, err := waitForNewSerialPort(); err != nil {
-			return nil, fmt.Errorf("cannot detect serial ports: %s", err)
+	actualPort := port
+	if portWatcher != nil {
+		progressCB(&rpc.UploadProgress{Phase: rpc.UploadProgress_WAIT_FOR_PORT, Message: "Waiting for upload port..."})
+		if p, err := portWatcher.wait(10 * time.Second); err != nil {
+			return "", fmt.Errorf("cannot detect serial ports: %s", err)
 		} else if p == "" {
 			feedback.Print("No new serial port detected.")
+			progressCB(&rpc.UploadProgress{Phase: rpc.UploadProgress_NEW_PORT, Message: "No new serial port detected, using " + port, Port: port, Completed: true})
 		} else {
 			actualPort = p
+			progressCB(&rpc.UploadProgress{Phase: rpc.UploadProgress_NEW_PORT, Message: "Upload port detected: " + p, Port: p, Completed: true})
 		}
 
 		// on OS X, if the port is opened too quickly after it is detected,
@@ -215,26 +316,282 @@ func Upload(ctx context.Context, req *rpc.UploadReq, outStream io.Writer, errStr
 		time.Sleep(500 * time.Millisecond)
 	}
 
-	// Set serial port property
-	uploadProperties.Set("serial.port", actualPort)
-	if strings.HasPrefix(actualPort, "/dev/") {
-		uploadProperties.Set("serial.port.file", actualPort[5:])
+	return actualPort, nil
+}
+
+// determineImportFile returns the folder and the name without extension
+// (the build.path and build.project_name properties) of the binary to
+// upload. The binary is, in order of preference:
+//   - the import file, if specified
+//   - the only binary with the given extension in the import dir, if
+//     specified
+//   - the binary exported in the sketch folder for the given board
+// The binaries that include the bootloader ("*.with_bootloader.*") are
+// preferred when uploading with a programmer, otherwise they are used
+// only if no other binary is found.
+func determineImportFile(req *rpc.UploadReq, sketch *sketches.Sketch, fqbn *cores.FQBN, ext string) (*paths.Path, string, error) {
+	var importPath *paths.Path
+	var importFile string
+
+	if req.GetImportFile() != "" {
+		file := paths.New(req.GetImportFile())
+		importPath = file.Parent()
+		importFile = file.Base()
+		if !strings.HasSuffix(importFile, ext) {
+			if file.Exist() {
+				return nil, "", fmt.Errorf("invalid file extension for %s: the board expects a '%s' file", file, ext)
+			}
+			// the extension may be omitted
+			importFile += ext
+		}
+	} else if req.GetImportDir() != "" {
+		importPath = paths.New(req.GetImportDir())
+		files, err := importPath.ReadDir()
+		if err != nil {
+			return nil, "", fmt.Errorf("reading import dir: %s", err)
+		}
+		binaries := []string{}
+		withBootloader := []string{}
+		for _, file := range files {
+			name := file.Base()
+			if file.IsDir() || !strings.HasSuffix(name, ext) {
+				continue
+			}
+			if strings.HasSuffix(name, ".with_bootloader"+ext) {
+				withBootloader = append(withBootloader, name)
+			} else {
+				binaries = append(binaries, name)
+			}
+		}
+		candidates := binaries
+		if len(binaries) == 0 || (req.GetProgrammer() != "" && len(withBootloader) > 0) {
+			candidates = withBootloader
+		}
+		if len(candidates) == 0 {
+			return nil, "", fmt.Errorf("no '%s' file found in %s", ext, importPath)
+		}
+		if len(candidates) > 1 {
+			return nil, "", fmt.Errorf("multiple '%s' files found in %s: %s", ext, importPath, strings.Join(candidates, ", "))
+		}
+		importFile = candidates[0]
 	} else {
-		uploadProperties.Set("serial.port.file", actualPort)
+		// Make the filename without the FQBN configs part
+		fqbn = &cores.FQBN{Package: fqbn.Package, PlatformArch: fqbn.PlatformArch, BoardID: fqbn.BoardID, Configs: properties.NewMap()}
+		fqbnSuffix := strings.Replace(fqbn.String(), ":", ".", -1)
+		importPath = sketch.FullPath
+		importFile = sketch.Name + "." + fqbnSuffix + ext
+		withBootloader := sketch.Name + "." + fqbnSuffix + ".with_bootloader" + ext
+		if exist, _ := importPath.Join(importFile).ExistCheck(); !exist || req.GetProgrammer() != "" {
+			if exist, _ := importPath.Join(withBootloader).ExistCheck(); exist {
+				importFile = withBootloader
+			}
+		}
 	}
 
-	// Build recipe for upload
-	recipe := uploadProperties.Get("upload.pattern")
-	cmdLine := uploadProperties.ExpandPropsInString(recipe)
+	uploadFile := importPath.Join(importFile)
+	if _, err := uploadFile.Stat(); err != nil {
+		if os.IsNotExist(err) {
+			return nil, "", fmt.Errorf("compiled sketch %s not found", uploadFile.String())
+		}
+		return nil, "", fmt.Errorf("cannot open sketch: %s", err)
+	}
+	return importPath, strings.TrimSuffix(importFile, ext), nil
+}
+
+// findProgrammer looks for the programmer with the given id in the platform
+// of the board and, if the board uses a core from another platform, in the
+// referenced platform. Returns nil if the programmer is not found.
+func findProgrammer(id string, boardPlatform, buildPlatform *cores.PlatformRelease) *properties.Map {
+	if programmer, ok := boardPlatform.Programmers[id]; ok {
+		return programmer
+	}
+	if buildPlatform != nil {
+		if programmer, ok := buildPlatform.Programmers[id]; ok {
+			return programmer
+		}
+	}
+	return nil
+}
+
+// getToolProperties builds the configuration needed to run the tool
+// specified by toolKey (for example "upload.tool"), merging the properties
+// of the platforms, the board, the programmer (if not nil) and the tool.
+func getToolProperties(pm *packagemanager.PackageManager, board *cores.Board, boardProperties *properties.Map,
+	programmer *properties.Map, toolKey string) (*properties.Map, error) {
+
+	actionProperties := boardProperties.Clone()
+	if programmer != nil {
+		actionProperties.Merge(programmer)
+	}
+
+	// Load programmer tool
+	toolName, have := actionProperties.GetOk(toolKey)
+	if !have || toolName == "" {
+		return nil, fmt.Errorf("cannot get programmer tool: undefined '%s' property", toolKey)
+	}
+
+	var referencedPlatformRelease *cores.PlatformRelease
+	if split := strings.Split(toolName, ":"); len(split) > 2 {
+		return nil, fmt.Errorf("invalid '%s' property: %s", toolKey, toolName)
+	} else if len(split) == 2 {
+		referencedPackageName := split[0]
+		toolName = split[1]
+		architecture := board.PlatformRelease.Platform.Architecture
+
+		if referencedPackage := pm.Packages[referencedPackageName]; referencedPackage == nil {
+			return nil, fmt.Errorf("required platform %s:%s not installed", referencedPackageName, architecture)
+		} else if referencedPlatform := referencedPackage.Platforms[architecture]; referencedPlatform == nil {
+			return nil, fmt.Errorf("required platform %s:%s not installed", referencedPackageName, architecture)
+		} else {
+			referencedPlatformRelease = pm.GetInstalledPlatformRelease(referencedPlatform)
+		}
+	}
+
+	toolProperties := properties.NewMap()
+	if referencedPlatformRelease != nil {
+		toolProperties.Merge(referencedPlatformRelease.Properties)
+	}
+	toolProperties.Merge(board.PlatformRelease.Properties)
+	toolProperties.Merge(board.PlatformRelease.RuntimeProperties())
+	toolProperties.Merge(actionProperties)
+	toolProperties.Merge(toolProperties.SubTree("tools." + toolName))
+
+	if requiredTools, err := pm.FindToolsRequiredForBoard(board); err == nil {
+		for _, requiredTool := range requiredTools {
+			logrus.WithField("tool", requiredTool).Info("Tool required for upload")
+			toolProperties.Merge(requiredTool.RuntimeProperties())
+		}
+	}
+
+	return toolProperties, nil
+}
+
+// setActionVerbosity sets the ACTION.verbose property using the
+// ACTION.params.verbose or ACTION.params.quiet values
+func setActionVerbosity(props *properties.Map, action string, verbose bool) {
+	if verbose {
+		if v, ok := props.GetOk(action + ".params.verbose"); ok {
+			props.Set(action+".verbose", v)
+		}
+	} else {
+		if v, ok := props.GetOk(action + ".params.quiet"); ok {
+			props.Set(action+".verbose", v)
+		}
+	}
+}
+
+// setActionVerify sets the ACTION.verify property using the
+// ACTION.params.verify or ACTION.params.noverify values
+func setActionVerify(props *properties.Map, action string, verify bool) {
+	if verify {
+		props.Set(action+".verify", props.Get(action+".params.verify"))
+	} else {
+		props.Set(action+".verify", props.Get(action+".params.noverify"))
+	}
+}
+
+func setSerialPortProperties(props *properties.Map, port string) {
+	props.Set("serial.port", port)
+	if strings.HasPrefix(port, "/dev/") {
+		props.Set("serial.port.file", port[5:])
+	} else {
+		props.Set("serial.port.file", port)
+	}
+}
+
+// setNetworkPortProperties sets the properties used by the network upload
+// recipes: the address of the board goes in serial.port (for compatibility
+// with the recipes written for the Arduino IDE) and the port, if any, in
+// network.port
+func setNetworkPortProperties(props *properties.Map, address string, password string) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+	props.Set("serial.port", host)
+	props.Set("serial.port.file", host)
+	if port != "" {
+		props.Set("network.port", port)
+	}
+	props.Set("network.password", password)
+}
+
+// maskedPassword replaces the network password in the dry run results
+const maskedPassword = "********"
+
+// dryRunResponse returns the command line and the properties of the upload,
+// the network password is masked
+func dryRunResponse(recipeID string, props *properties.Map) (*rpc.UploadResp, error) {
+	if props.Get("network.password") != "" {
+		props = props.Clone()
+		props.Set("network.password", maskedPassword)
+	}
+	cmdArgs, err := toolCommandLine(recipeID, props)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.UploadResp{
+		CommandLine: cmdArgs,
+		ToolPaths:   toolPaths(props),
+		Properties:  props.AsMap(),
+	}, nil
+}
+
+// isNetworkAddress returns true if the port looks like the address of a
+// network board (an IP address or an mDNS host name, with an optional port)
+// rather than a serial port
+func isNetworkAddress(port string) bool {
+	host, _, err := net.SplitHostPort(port)
+	if err != nil {
+		host = port
+	}
+	return net.ParseIP(host) != nil || strings.HasSuffix(host, ".local")
+}
+
+// toolCommandLine expands the recipe with the given key and splits the
+// resulting command line into arguments.
+func toolCommandLine(recipeID string, props *properties.Map) ([]string, error) {
+	recipe, ok := props.GetOk(recipeID)
+	if !ok {
+		return nil, fmt.Errorf("recipe not found '%s'", recipeID)
+	}
+	cmdLine := props.ExpandPropsInString(recipe)
 	cmdArgs, err := properties.SplitQuotedString(cmdLine, `"'`, false)
 	if err != nil {
 		return nil, fmt.Errorf("invalid recipe '%s': %s", recipe, err)
 	}
+	return cmdArgs, nil
+}
+
+// toolPaths returns the paths of the tools available to the recipes, as
+// set in the runtime.tools.TOOLNAME.path properties, indexed by tool name.
+func toolPaths(props *properties.Map) map[string]string {
+	res := map[string]string{}
+	for key, value := range props.AsMap() {
+		if strings.HasPrefix(key, "runtime.tools.") && strings.HasSuffix(key, ".path") {
+			name := strings.TrimSuffix(strings.TrimPrefix(key, "runtime.tools."), ".path")
+			res[name] = value
+		}
+	}
+	return res
+}
+
+// runTool expands the recipe with the given key and runs the resulting
+// command line, waiting for its completion. The tool is killed if the
+// context is done before its completion: in that case ErrUploadCancelled
+// or ErrUploadTimeout is returned. The start and the exit of the tool are
+// reported to progressCB.
+func runTool(ctx context.Context, recipeID string, props *properties.Map, outStream io.Writer, errStream io.Writer,
+	progressCB commands.UploadProgressCB) error {
+	cmdArgs, err := toolCommandLine(recipeID, props)
+	if err != nil {
+		return err
+	}
 
 	// Run Tool
 	cmd, err := executils.Command(cmdArgs)
 	if err != nil {
-		return nil, fmt.Errorf("cannot execute upload tool: %s", err)
+		return fmt.Errorf("cannot execute upload tool: %s", err)
 	}
 
 	executils.AttachStdoutListener(cmd, executils.PrintToStdout)
@@ -243,16 +600,34 @@ func Upload(ctx context.Context, req *rpc.UploadReq, outStream io.Writer, errStr
 	cmd.Stderr = errStream
 
 	if err := cmd.Start(); err != nil {
-		return nil, fmt.Errorf("cannot execute upload tool: %s", err)
+		return fmt.Errorf("cannot execute upload tool: %s", err)
 	}
-
-	if err := cmd.Wait(); err != nil {
-		return nil, fmt.Errorf("uploading error: %s", err)
+	tool := filepath.Base(cmdArgs[0])
+	progressCB(&rpc.UploadProgress{Phase: rpc.UploadProgress_TOOL_START, Message: "Running " + tool})
+
+	waitErr := make(chan error, 1)
+	go func() { waitErr <- cmd.Wait() }()
+	select {
+	case err := <-waitErr:
+		progressCB(&rpc.UploadProgress{
+			Phase:     rpc.UploadProgress_TOOL_EXIT,
+			Message:   tool + " exited with code " + strconv.Itoa(cmd.ProcessState.ExitCode()),
+			ExitCode:  int32(cmd.ProcessState.ExitCode()),
+			Completed: true,
+		})
+		return err
+	case <-ctx.Done():
+		logrus.WithField("pid", cmd.Process.Pid).Info("Killing upload tool")
+		if err := cmd.Process.Kill(); err != nil {
+			logrus.WithError(err).Warn("Cannot kill upload tool")
+		}
+		<-waitErr
+		progressCB(&rpc.UploadProgress{Phase: rpc.UploadProgress_TOOL_EXIT, Message: tool + " killed", ExitCode: -1, Completed: true})
+		if ctx.Err() == context.DeadlineExceeded {
+			return ErrUploadTimeout
+		}
+		return ErrUploadCancelled
 	}
-
-	logrus.Tracef("Upload %s on %s successful", sketch.Name, fqbnIn)
-
-	return &rpc.UploadResp{}, nil
 }
 
 func touchSerialPortAt1200bps(port string) error {
@@ -270,45 +645,3 @@ func touchSerialPortAt1200bps(port string) error {
 	}
 	return nil
 }
-
-// waitForNewSerialPort is meant to be called just after a reset. It watches the ports connected
-// to the machine until a port appears. The new appeared port is returned
-func waitForNewSerialPort() (string, error) {
-	logrus.Infof("Waiting for upload port...")
-
-	getPortMap := func() (map[string]bool, error) {
-		ports, err := serial.GetPortsList()
-		if err != nil {
-			return nil, err
-		}
-		res := map[string]bool{}
-		for _, port := range ports {
-			res[port] = true
-		}
-		return res, nil
-	}
-
-	last, err := getPortMap()
-	if err != nil {
-		return "", fmt.Errorf("scanning serial port: %s", err)
-	}
-
-	deadline := time.Now().Add(10 * time.Second)
-	for time.Now().Before(deadline) {
-		now, err := getPortMap()
-		if err != nil {
-			return "", fmt.Errorf("scanning serial port: %s", err)
-		}
-
-		for p := range now {
-			if !last[p] {
-				return p, nil // Found it!
-			}
-		}
-
-		last = now
-		time.Sleep(250 * time.Millisecond)
-	}
-
-	return "", nil
-}
@@ -0,0 +1,196 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package upload
+
+import (
+	"context"
+	"io/ioutil"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/sketches"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsNetworkAddress(t *testing.T) {
+	require.True(t, isNetworkAddress("192.168.1.20"))
+	require.True(t, isNetworkAddress("192.168.1.20:3232"))
+	require.True(t, isNetworkAddress("[fe80::1]:3232"))
+	require.True(t, isNetworkAddress("esp32-ota.local"))
+	require.False(t, isNetworkAddress("/dev/ttyACM0"))
+	require.False(t, isNetworkAddress("COM10"))
+}
+
+func TestSetNetworkPortProperties(t *testing.T) {
+	props := properties.NewMap()
+	props.Set("network.port", "8266")
+	setNetworkPortProperties(props, "192.168.1.20", "secret")
+	require.Equal(t, "192.168.1.20", props.Get("serial.port"))
+	require.Equal(t, "8266", props.Get("network.port"))
+	require.Equal(t, "secret", props.Get("network.password"))
+
+	setNetworkPortProperties(props, "192.168.1.20:3232", "")
+	require.Equal(t, "192.168.1.20", props.Get("serial.port"))
+	require.Equal(t, "3232", props.Get("network.port"))
+	require.Equal(t, "", props.Get("network.password"))
+}
+
+func TestDetermineImportFile(t *testing.T) {
+	dir, err := paths.MkTempDir("", "upload-test")
+	require.NoError(t, err)
+	defer dir.RemoveAll()
+	for _, file := range []string{"firmware.hex", "firmware.with_bootloader.hex", "firmware.bin"} {
+		require.NoError(t, dir.Join(file).WriteFile([]byte{}))
+	}
+	fqbn, err := cores.ParseFQBN("arduino:avr:uno")
+	require.NoError(t, err)
+
+	// import file, the extension may be omitted
+	for _, file := range []string{"firmware.hex", "firmware"} {
+		path, name, err := determineImportFile(&rpc.UploadReq{ImportFile: dir.Join(file).String()}, nil, fqbn, ".hex")
+		require.NoError(t, err)
+		require.Equal(t, dir.String(), path.String())
+		require.Equal(t, "firmware", name)
+	}
+	_, _, err = determineImportFile(&rpc.UploadReq{ImportFile: dir.Join("firmware.bin").String()}, nil, fqbn, ".hex")
+	require.Error(t, err)
+	_, _, err = determineImportFile(&rpc.UploadReq{ImportFile: dir.Join("missing.hex").String()}, nil, fqbn, ".hex")
+	require.Error(t, err)
+
+	// import dir, the binary is selected by extension
+	_, name, err := determineImportFile(&rpc.UploadReq{ImportDir: dir.String()}, nil, fqbn, ".hex")
+	require.NoError(t, err)
+	require.Equal(t, "firmware", name)
+	_, name, err = determineImportFile(&rpc.UploadReq{ImportDir: dir.String()}, nil, fqbn, ".bin")
+	require.NoError(t, err)
+	require.Equal(t, "firmware", name)
+	_, _, err = determineImportFile(&rpc.UploadReq{ImportDir: dir.String()}, nil, fqbn, ".uf2")
+	require.Error(t, err)
+
+	// the binary with the bootloader is preferred with a programmer
+	_, name, err = determineImportFile(&rpc.UploadReq{ImportDir: dir.String(), Programmer: "usbasp"}, nil, fqbn, ".hex")
+	require.NoError(t, err)
+	require.Equal(t, "firmware.with_bootloader", name)
+	_, name, err = determineImportFile(&rpc.UploadReq{ImportDir: dir.String(), Programmer: "usbasp"}, nil, fqbn, ".bin")
+	require.NoError(t, err)
+	require.Equal(t, "firmware", name)
+
+	require.NoError(t, dir.Join("other.hex").WriteFile([]byte{}))
+	_, _, err = determineImportFile(&rpc.UploadReq{ImportDir: dir.String()}, nil, fqbn, ".hex")
+	require.Error(t, err)
+
+	// the binary with the bootloader is used if it's the only one
+	require.NoError(t, dir.Join("firmware.hex").Remove())
+	require.NoError(t, dir.Join("other.hex").Remove())
+	_, name, err = determineImportFile(&rpc.UploadReq{ImportDir: dir.String()}, nil, fqbn, ".hex")
+	require.NoError(t, err)
+	require.Equal(t, "firmware.with_bootloader", name)
+
+	// sketch folder
+	sketch := &sketches.Sketch{Name: "Blink", FullPath: dir}
+	require.NoError(t, dir.Join("Blink.arduino.avr.uno.with_bootloader.hex").WriteFile([]byte{}))
+	_, name, err = determineImportFile(&rpc.UploadReq{}, sketch, fqbn, ".hex")
+	require.NoError(t, err)
+	require.Equal(t, "Blink.arduino.avr.uno.with_bootloader", name)
+	require.NoError(t, dir.Join("Blink.arduino.avr.uno.hex").WriteFile([]byte{}))
+	_, name, err = determineImportFile(&rpc.UploadReq{}, sketch, fqbn, ".hex")
+	require.NoError(t, err)
+	require.Equal(t, "Blink.arduino.avr.uno", name)
+	_, name, err = determineImportFile(&rpc.UploadReq{Programmer: "usbasp"}, sketch, fqbn, ".hex")
+	require.NoError(t, err)
+	require.Equal(t, "Blink.arduino.avr.uno.with_bootloader", name)
+}
+
+func TestToolCommandLine(t *testing.T) {
+	props := properties.NewMap()
+	props.Set("runtime.tools.avrdude.path", "/opt/tools/avrdude/6.3.0")
+	props.Set("runtime.tools.avrdude-6.3.0.path", "/opt/tools/avrdude/6.3.0")
+	props.Set("tools.avrdude.cmd.path", "{runtime.tools.avrdude.path}/bin/avrdude")
+	props.Set("serial.port", "/dev/ttyACM0")
+	props.Set("build.path", "/tmp/build dir")
+	props.Set("upload.pattern", `"{tools.avrdude.cmd.path}" -P{serial.port} "-Uflash:w:{build.path}/sketch.hex:i"`)
+
+	args, err := toolCommandLine("upload.pattern", props)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"/opt/tools/avrdude/6.3.0/bin/avrdude",
+		"-P/dev/ttyACM0",
+		"-Uflash:w:/tmp/build dir/sketch.hex:i",
+	}, args)
+
+	_, err = toolCommandLine("program.pattern", props)
+	require.Error(t, err)
+
+	require.Equal(t, map[string]string{
+		"avrdude":       "/opt/tools/avrdude/6.3.0",
+		"avrdude-6.3.0": "/opt/tools/avrdude/6.3.0",
+	}, toolPaths(props))
+}
+
+func TestDryRunMasksPassword(t *testing.T) {
+	props := properties.NewMap()
+	props.Set("upload.network_pattern", `"{network_cmd}" -i "{serial.port}" "--auth={network.password}"`)
+	props.Set("network_cmd", "espota")
+	setNetworkPortProperties(props, "192.168.1.10", "secret")
+
+	res, err := dryRunResponse("upload.network_pattern", props)
+	require.NoError(t, err)
+	require.Equal(t, []string{"espota", "-i", "192.168.1.10", "--auth=********"}, res.GetCommandLine())
+	require.Equal(t, "********", res.GetProperties()["network.password"])
+	require.Equal(t, "secret", props.Get("network.password"))
+
+	// an empty password is left empty
+	setNetworkPortProperties(props, "192.168.1.10", "")
+	res, err = dryRunResponse("upload.network_pattern", props)
+	require.NoError(t, err)
+	require.Equal(t, "", res.GetProperties()["network.password"])
+}
+
+func TestRunToolInterrupted(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("sleep and false commands are not available on Windows")
+	}
+	props := properties.NewMap()
+	props.Set("upload.pattern", "sleep 10")
+	props.Set("fail.pattern", "false")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := runTool(ctx, "upload.pattern", props, ioutil.Discard, ioutil.Discard, func(*rpc.UploadProgress) {})
+	require.Equal(t, ErrUploadTimeout, err)
+	require.True(t, time.Since(start) < 5*time.Second)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+	}()
+	err = runTool(ctx, "upload.pattern", props, ioutil.Discard, ioutil.Discard, func(*rpc.UploadProgress) {})
+	require.Equal(t, ErrUploadCancelled, err)
+
+	err = runTool(context.Background(), "fail.pattern", props, ioutil.Discard, ioutil.Discard, func(*rpc.UploadProgress) {})
+	require.Error(t, err)
+	require.NotEqual(t, ErrUploadCancelled, err)
+	require.NotEqual(t, ErrUploadTimeout, err)
+}
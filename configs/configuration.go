@@ -46,6 +46,16 @@ type Configuration struct {
 	// Use DownloadsDir() method to retrieve it.
 	ArduinoDownloadsDir *paths.Path
 
+	// ArduinoBuildCacheDir overrides the default directory of the object files cache shared across builds.
+	// Use BuildCacheDir() method to retrieve it.
+	ArduinoBuildCacheDir *paths.Path
+
+	// BuildCacheMaxSize is the maximum size in bytes of the object files cache, 0 means unlimited.
+	BuildCacheMaxSize int64
+
+	// BuildCacheDisabled disables the object files cache shared across builds.
+	BuildCacheDisabled bool
+
 	// IDEBundledCheckResult contains the result of the check to see if the CLI is bundled with the IDE:
 	// the field is true if the CLI is bundled with the Arduino IDE, false if the CLI is running
 	// standalone or nil if the detection has not been performed.
@@ -69,6 +79,9 @@ type Configuration struct {
 
 var defaultPackageIndexURL, _ = url.Parse("https://downloads.arduino.cc/packages/package_index.json")
 
+// DefaultBuildCacheMaxSize is the default maximum size of the object files cache
+const DefaultBuildCacheMaxSize = 1024 * 1024 * 1024
+
 // NewConfiguration returns a new Configuration with the default values
 func NewConfiguration() (*Configuration, error) {
 	dataDir, err := getDefaultArduinoDataDir()
@@ -86,6 +99,7 @@ func NewConfiguration() (*Configuration, error) {
 		SketchbookDir:              sketchbookDir,
 		BoardManagerAdditionalUrls: []*url.URL{defaultPackageIndexURL},
 		ProxyType:                  "auto",
+		BuildCacheMaxSize:          DefaultBuildCacheMaxSize,
 	}, nil
 }
 
@@ -107,6 +121,21 @@ func (config *Configuration) DownloadsDir() *paths.Path {
 	return config.DataDir.Join("staging")
 }
 
+// BuildCacheDir returns the directory of the object files cache shared
+// across builds.
+func (config *Configuration) BuildCacheDir() *paths.Path {
+	if config.ArduinoBuildCacheDir != nil {
+		return config.ArduinoBuildCacheDir
+	}
+	return config.DataDir.Join("build-cache")
+}
+
+// MonitorRecordsDir returns the directory where the daemon records the
+// monitor sessions.
+func (config *Configuration) MonitorRecordsDir() *paths.Path {
+	return config.DataDir.Join("monitor-records")
+}
+
 // IndexesDir returns the directory for the indexes
 func (config *Configuration) IndexesDir() *paths.Path {
 	return config.DataDir
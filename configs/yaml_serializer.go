@@ -33,6 +33,13 @@ type yamlConfig struct {
 	ArduinoDataDir      string                   `yaml:"arduino_data,omitempty"`
 	ArduinoDownloadsDir string                   `yaml:"arduino_downloads_dir,omitempty"`
 	BoardsManager       *yamlBoardsManagerConfig `yaml:"board_manager"`
+	BuildCache          *yamlBuildCacheConfig    `yaml:"build_cache,omitempty"`
+}
+
+type yamlBuildCacheConfig struct {
+	Path      string `yaml:"path,omitempty"`
+	MaxSizeMB *int64 `yaml:"max_size_mb,omitempty"` // 0 means unlimited
+	Disabled  bool   `yaml:"disabled,omitempty"`
 }
 
 type yamlBoardsManagerConfig struct {
@@ -68,6 +75,15 @@ func (config *Configuration) LoadFromYAML(path *paths.Path) error {
 	} else {
 		config.ArduinoDownloadsDir = nil
 	}
+	if ret.BuildCache != nil {
+		if ret.BuildCache.Path != "" {
+			config.ArduinoBuildCacheDir = paths.New(ret.BuildCache.Path)
+		}
+		if ret.BuildCache.MaxSizeMB != nil {
+			config.BuildCacheMaxSize = *ret.BuildCache.MaxSizeMB * 1024 * 1024
+		}
+		config.BuildCacheDisabled = ret.BuildCache.Disabled
+	}
 	if ret.ProxyType != "" {
 		config.ProxyType = ret.ProxyType
 		if ret.ProxyManualConfig != nil {
@@ -104,6 +120,17 @@ func (config *Configuration) SerializeToYAML() ([]byte, error) {
 	if config.ArduinoDownloadsDir != nil {
 		c.ArduinoDownloadsDir = config.ArduinoDownloadsDir.String()
 	}
+	if config.ArduinoBuildCacheDir != nil || config.BuildCacheMaxSize != DefaultBuildCacheMaxSize || config.BuildCacheDisabled {
+		c.BuildCache = &yamlBuildCacheConfig{}
+		if config.ArduinoBuildCacheDir != nil {
+			c.BuildCache.Path = config.ArduinoBuildCacheDir.String()
+		}
+		if config.BuildCacheMaxSize != DefaultBuildCacheMaxSize {
+			maxSizeMB := config.BuildCacheMaxSize / (1024 * 1024)
+			c.BuildCache.MaxSizeMB = &maxSizeMB
+		}
+		c.BuildCache.Disabled = config.BuildCacheDisabled
+	}
 	c.ProxyType = config.ProxyType
 	if config.ProxyType == "manual" {
 		c.ProxyManualConfig = &yamlProxyConfig{
@@ -4,18 +4,14 @@ go 1.13
 
 require (
 	bou.ke/monkey v1.0.1
-	github.com/arduino/board-discovery v0.0.0-20180823133458-1ba29327fb0c
 	github.com/arduino/go-paths-helper v1.0.1
 	github.com/arduino/go-properties-orderedmap v0.0.0-20190828172252-05018b28ff6c
 	github.com/arduino/go-timeutils v0.0.0-20171220113728-d1dd9e313b1b
 	github.com/arduino/go-win32-utils v0.0.0-20180330194947-ed041402e83b
 	github.com/cmaglie/pb v1.0.27
-	github.com/codeclysm/cc v1.2.2 // indirect
 	github.com/codeclysm/extract v2.2.0+incompatible
 	github.com/creack/goselect v0.0.0-20180328191401-176c667f75aa // indirect
 	github.com/fatih/color v1.7.0
-	github.com/fluxio/iohelpers v0.0.0-20160419043813-3a4dd67a94d2 // indirect
-	github.com/fluxio/multierror v0.0.0-20160419044231-9c68d39025e5 // indirect
 	github.com/fsnotify/fsnotify v1.4.7
 	github.com/go-errors/errors v1.0.1
 	github.com/golang/protobuf v1.3.2
@@ -27,14 +23,13 @@ require (
 	github.com/mattn/go-runewidth v0.0.2 // indirect
 	github.com/miekg/dns v1.0.5 // indirect
 	github.com/mitchellh/go-homedir v1.1.0
-	github.com/oleksandr/bonjour v0.0.0-20160508152359-5dcf00d8b228 // indirect
+	github.com/oleksandr/bonjour v0.0.0-20160508152359-5dcf00d8b228
 	github.com/pkg/errors v0.8.1
 	github.com/pmylund/sortutil v0.0.0-20120526081524-abeda66eb583
 	github.com/rifflock/lfshook v0.0.0-20180920164130-b9218ef580f5
 	github.com/schollz/closestmatch v2.1.0+incompatible
 	github.com/sergi/go-diff v1.0.0
 	github.com/sirupsen/logrus v1.4.2
-	github.com/smartystreets/goconvey v0.0.0-20190330032615-68dc04aab96a // indirect
 	github.com/spf13/cobra v0.0.5
 	github.com/stretchr/testify v1.3.0
 	go.bug.st/cleanup v1.0.0
@@ -30,6 +30,7 @@
 package builder
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"reflect"
@@ -64,6 +65,11 @@ func (s *Builder) Run(ctx *types.Context) error {
 		return err
 	}
 
+	ctx.CompilationDatabase = bldr.NewCompilationDatabase(ctx.BuildPath.Join("compile_commands.json"))
+	if ctx.OnlyUpdateCompilationDatabase {
+		return updateCompilationDatabase(ctx)
+	}
+
 	commands := []types.Command{
 		&ContainerSetupHardwareToolsLibsSketchAndProps{},
 
@@ -122,6 +128,8 @@ func (s *Builder) Run(ctx *types.Context) error {
 
 		&ExportProjectCMake{SketchError: mainErr != nil},
 
+		&ExportCompilationDatabase{},
+
 		&phases.Sizer{SketchError: mainErr != nil},
 	}
 	otherErr := runCommands(ctx, commands, false)
@@ -133,6 +141,32 @@ func (s *Builder) Run(ctx *types.Context) error {
 	return otherErr
 }
 
+// updateCompilationDatabase goes through the build steps that produce the
+// compile commands, without running the compiler, and saves the compilation
+// database. Hooks, linking and the other post-build steps are skipped.
+func updateCompilationDatabase(ctx *types.Context) error {
+	commands := []types.Command{
+		&ContainerSetupHardwareToolsLibsSketchAndProps{},
+
+		&ContainerBuildOptions{},
+
+		&ContainerMergeCopySketchFiles{},
+
+		utils.LogIfVerbose(constants.LOG_LEVEL_INFO, "Detecting libraries used..."),
+		&ContainerFindIncludes{},
+
+		utils.LogIfVerbose(constants.LOG_LEVEL_INFO, "Generating function prototypes..."),
+		&PreprocessSketch{},
+
+		&phases.SketchBuilder{},
+		&phases.LibrariesBuilder{},
+		&phases.CoreBuilder{},
+
+		&ExportCompilationDatabase{},
+	}
+	return runCommands(ctx, commands, true)
+}
+
 type PreprocessSketch struct{}
 
 func (s *PreprocessSketch) Run(ctx *types.Context) error {
@@ -148,6 +182,29 @@ func (s *PreprocessSketch) Run(ctx *types.Context) error {
 type Preprocess struct{}
 
 func (s *Preprocess) Run(ctx *types.Context) error {
+	if err := runPreprocessCommands(ctx); err != nil {
+		return err
+	}
+
+	// Output arduino-preprocessed source
+	fmt.Println(ctx.Source)
+	return nil
+}
+
+// CodeComplete runs the preprocessing of the sketch with arduino-preprocessor
+// to compute the code completions at ctx.CodeCompleteAt, the result is
+// stored in ctx.CodeCompletions
+type CodeComplete struct{}
+
+func (s *CodeComplete) Run(ctx *types.Context) error {
+	if ctx.CodeCompleteAt == "" {
+		return errors.New("missing code completion position")
+	}
+	ctx.UseArduinoPreprocessor = true
+	return runPreprocessCommands(ctx)
+}
+
+func runPreprocessCommands(ctx *types.Context) error {
 	if ctx.BuildPath == nil {
 		ctx.BuildPath = bldr.GenBuildPath(ctx.SketchLocation)
 	}
@@ -172,13 +229,7 @@ func (s *Preprocess) Run(ctx *types.Context) error {
 		&PreprocessSketch{},
 	}
 
-	if err := runCommands(ctx, commands, true); err != nil {
-		return err
-	}
-
-	// Output arduino-preprocessed source
-	fmt.Println(ctx.Source)
-	return nil
+	return runCommands(ctx, commands, true)
 }
 
 type ParseHardwareAndDumpBuildProperties struct{}
@@ -234,3 +285,8 @@ func RunPreprocess(ctx *types.Context) error {
 	command := Preprocess{}
 	return command.Run(ctx)
 }
+
+func RunCodeComplete(ctx *types.Context) error {
+	command := CodeComplete{}
+	return command.Run(ctx)
+}
@@ -38,6 +38,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/arduino/arduino-cli/arduino/builder"
 	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/i18n"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
@@ -230,41 +231,194 @@ func compileFilesWithRecipe(ctx *types.Context, sourcePath *paths.Path, sources
 	return objectFiles, nil
 }
 
-func compileFileWithRecipe(ctx *types.Context, sourcePath *paths.Path, source *paths.Path, buildPath *paths.Path, buildProperties *properties.Map, includes []string, recipe string) (*paths.Path, error) {
-	logger := ctx.GetLogger()
+// AddFilesToCompilationDatabase records in the compilation database the
+// commands to compile the files in sourcePath, without compiling them. It's
+// used for the files that are not compiled because their objects are
+// already available, e.g. the core when the cached archive is used.
+func AddFilesToCompilationDatabase(ctx *types.Context, sourcePath *paths.Path, recurse bool, buildPath *paths.Path, buildProperties *properties.Map, includes []string) error {
+	if ctx.CompilationDatabase == nil {
+		return nil
+	}
+	recipes := [][2]string{
+		{".S", constants.RECIPE_S_PATTERN},
+		{".c", constants.RECIPE_C_PATTERN},
+		{".cpp", constants.RECIPE_CPP_PATTERN},
+	}
+	for _, recipe := range recipes {
+		sources, err := findFilesInFolder(sourcePath, recipe[0], recurse)
+		if err != nil {
+			return i18n.WrapError(err)
+		}
+		for _, source := range sources {
+			command, _, _, err := prepareCompileCommand(ctx, sourcePath, source, buildPath, buildProperties, includes, recipe[1])
+			if err != nil {
+				return i18n.WrapError(err)
+			}
+			addToCompilationDatabase(ctx, source, command)
+		}
+	}
+	return nil
+}
+
+// prepareCompileCommand returns the command to compile the source file with
+// the given recipe, the object file and the dependencies file it produces
+func prepareCompileCommand(ctx *types.Context, sourcePath *paths.Path, source *paths.Path, buildPath *paths.Path, buildProperties *properties.Map, includes []string, recipe string) (*exec.Cmd, *paths.Path, *paths.Path, error) {
 	properties := buildProperties.Clone()
 	properties.Set(constants.BUILD_PROPERTIES_COMPILER_WARNING_FLAGS, properties.Get(constants.BUILD_PROPERTIES_COMPILER_WARNING_FLAGS+"."+ctx.WarningsLevel))
 	properties.Set(constants.BUILD_PROPERTIES_INCLUDES, strings.Join(includes, constants.SPACE))
 	properties.SetPath(constants.BUILD_PROPERTIES_SOURCE_FILE, source)
 	relativeSource, err := sourcePath.RelTo(source)
 	if err != nil {
-		return nil, i18n.WrapError(err)
+		return nil, nil, nil, i18n.WrapError(err)
 	}
 	depsFile := buildPath.Join(relativeSource.String() + ".d")
 	objectFile := buildPath.Join(relativeSource.String() + ".o")
-
 	properties.SetPath(constants.BUILD_PROPERTIES_OBJECT_FILE, objectFile)
+
+	command, err := PrepareCommandForRecipe(ctx, properties, recipe, false)
+	if err != nil {
+		return nil, nil, nil, i18n.WrapError(err)
+	}
+	return command, objectFile, depsFile, nil
+}
+
+func compileFileWithRecipe(ctx *types.Context, sourcePath *paths.Path, source *paths.Path, buildPath *paths.Path, buildProperties *properties.Map, includes []string, recipe string) (*paths.Path, error) {
+	logger := ctx.GetLogger()
+	command, objectFile, depsFile, err := prepareCompileCommand(ctx, sourcePath, source, buildPath, buildProperties, includes, recipe)
+	if err != nil {
+		return nil, i18n.WrapError(err)
+	}
 	err = objectFile.Parent().MkdirAll()
 	if err != nil {
 		return nil, i18n.WrapError(err)
 	}
 
+	if ctx.CompilationDatabase != nil {
+		addToCompilationDatabase(ctx, source, command)
+	}
+	if ctx.OnlyUpdateCompilationDatabase {
+		return objectFile, nil
+	}
+
+	// the output of the compiler is kept in the build path, to report the
+	// warnings of the up to date objects too
+	stderrFile := paths.New(strings.TrimSuffix(depsFile.String(), ".d") + ".stderr")
+
 	objIsUpToDate, err := ObjFileIsUpToDate(ctx, source, objectFile, depsFile)
 	if err != nil {
 		return nil, i18n.WrapError(err)
 	}
 	if !objIsUpToDate {
-		_, _, err = ExecRecipe(ctx, properties, recipe, false /* stdout */, utils.ShowIfVerbose /* stderr */, utils.Show)
+		// the failures of the object cache must not break the build, in
+		// that case the file is simply compiled
+		cacheKey := ""
+		if ctx.ObjectCache != nil {
+			cacheKey, err = ctx.ObjectCache.Key(source, command.Args, ctx.BuildPath)
+			restored := false
+			var stderr []byte
+			if err == nil {
+				restored, stderr, err = ctx.ObjectCache.Restore(cacheKey, objectFile, depsFile, ctx.BuildPath)
+			}
+			if err != nil && ctx.Verbose {
+				logger.Println(constants.LOG_LEVEL_WARN, "Could not use the object cache for {0}: {1}", source, err)
+			}
+			if restored {
+				if ctx.Verbose {
+					logger.Println(constants.LOG_LEVEL_INFO, "Using cached object file: {0}", objectFile)
+				}
+				// the warnings emitted when the object was compiled are
+				// reported again
+				reportCompilerOutput(ctx, source, stderr)
+				saveCompilerOutput(stderrFile, stderr)
+				return objectFile, nil
+			}
+		}
+
+		// stderr is captured to extract the diagnostics, and then shown
+		_, stderr, err := utils.ExecCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.Capture /* stderr */)
+		reportCompilerOutput(ctx, source, stderr)
 		if err != nil {
+			stderrFile.Remove()
 			return nil, i18n.WrapError(err)
 		}
-	} else if ctx.Verbose {
-		logger.Println(constants.LOG_LEVEL_INFO, constants.MSG_USING_PREVIOUS_COMPILED_FILE, objectFile)
+		saveCompilerOutput(stderrFile, stderr)
+
+		if cacheKey != "" {
+			if err := ctx.ObjectCache.Store(cacheKey, objectFile, depsFile, ctx.BuildPath, stderr); err != nil && ctx.Verbose {
+				logger.Println(constants.LOG_LEVEL_WARN, "Could not cache object file {0}: {1}", objectFile, err)
+			}
+		}
+	} else {
+		if ctx.Verbose {
+			logger.Println(constants.LOG_LEVEL_INFO, constants.MSG_USING_PREVIOUS_COMPILED_FILE, objectFile)
+		}
+		if stderr, err := stderrFile.ReadFile(); err == nil {
+			reportCompilerOutput(ctx, source, stderr)
+		}
 	}
 
 	return objectFile, nil
 }
 
+// saveCompilerOutput keeps the output of the compiler in the given file,
+// the file is removed if there is no output
+func saveCompilerOutput(file *paths.Path, stderr []byte) {
+	if len(stderr) == 0 {
+		file.Remove()
+		return
+	}
+	file.WriteFile(stderr)
+}
+
+// reportCompilerOutput shows the output of the compiler and extracts the
+// diagnostics from it
+func reportCompilerOutput(ctx *types.Context, source *paths.Path, stderr []byte) {
+	if len(stderr) == 0 {
+		return
+	}
+	ctx.ExecStderr.Write(stderr)
+	ctx.AddCompilerDiagnostics(parseCompilerDiagnostics(source, stderr))
+}
+
+// addToCompilationDatabase records the command used to compile the source
+// file. The copies of the sketch files in the build path are replaced by the
+// original files, the merged sketch (.ino.cpp) has no original counterpart.
+func addToCompilationDatabase(ctx *types.Context, source *paths.Path, command *exec.Cmd) {
+	if ctx.SketchBuildPath != nil && ctx.SketchLocation != nil && !strings.HasSuffix(source.String(), ".ino.cpp") {
+		if inside, _ := source.IsInsideDir(ctx.SketchBuildPath); inside {
+			sketchDir := ctx.SketchLocation
+			if !sketchDir.IsDir() {
+				sketchDir = sketchDir.Parent()
+			}
+			relSource, err := ctx.SketchBuildPath.RelTo(source)
+			if original := sketchDir.JoinPath(relSource); err == nil && original.Exist() {
+				args := append([]string{}, command.Args...)
+				for i, arg := range args {
+					if arg == source.String() {
+						args[i] = original.String()
+					}
+				}
+				command = &exec.Cmd{Path: command.Path, Args: args, Dir: command.Dir}
+				source = original
+			}
+		}
+	}
+	ctx.CompilationDatabase.Add(source, command)
+}
+
+// parseCompilerDiagnostics extracts the diagnostics from the output of the
+// compiler. The locations in the merged sketch (.ino.cpp) are mapped back to
+// the original sketch files.
+func parseCompilerDiagnostics(source *paths.Path, output []byte) []*builder.Diagnostic {
+	diagnostics := builder.ParseGccDiagnostics(output)
+	if strings.HasSuffix(source.String(), ".ino.cpp") {
+		if content, err := source.ReadFile(); err == nil {
+			builder.NewSourceMapper(source.String(), content).MapDiagnostics(diagnostics)
+		}
+	}
+	return diagnostics
+}
+
 func ObjFileIsUpToDate(ctx *types.Context, sourceFile, objectFile, dependencyFile *paths.Path) (bool, error) {
 	logger := ctx.GetLogger()
 	debugLevel := ctx.DebugLevel
@@ -453,6 +607,11 @@ func ArchiveCompiledFiles(ctx *types.Context, buildPath *paths.Path, archiveFile
 	logger := ctx.GetLogger()
 	archiveFilePath := buildPath.JoinPath(archiveFile)
 
+	if ctx.OnlyUpdateCompilationDatabase {
+		// the object files have not been compiled
+		return archiveFilePath, nil
+	}
+
 	rebuildArchive := false
 
 	if archiveFileStat, err := archiveFilePath.Stat(); err == nil {
@@ -0,0 +1,53 @@
+/*
+ * This file is part of Arduino Builder.
+ *
+ * Arduino Builder is free software; you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation; either version 2 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin St, Fifth Floor, Boston, MA  02110-1301  USA
+ *
+ * As a special exception, you may use this file as part of a free software
+ * library without restriction.  Specifically, if other files instantiate
+ * templates or use macros or inline functions from this file, or you compile
+ * this file and link it with other files to produce an executable, this
+ * file does not by itself cause the resulting executable to be covered by
+ * the GNU General Public License.  This exception does not however
+ * invalidate any other reasons why the executable file might be covered by
+ * the GNU General Public License.
+ *
+ * Copyright 2015 Arduino LLC (http://www.arduino.cc/)
+ */
+
+package builder
+
+import (
+	"github.com/arduino/arduino-cli/legacy/builder/constants"
+	"github.com/arduino/arduino-cli/legacy/builder/i18n"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+)
+
+// ExportCompilationDatabase saves the commands used to compile the sketch,
+// the libraries and the core in compile_commands.json
+type ExportCompilationDatabase struct{}
+
+func (s *ExportCompilationDatabase) Run(ctx *types.Context) error {
+	if ctx.CompilationDatabase == nil {
+		return nil
+	}
+	if err := ctx.CompilationDatabase.SaveToFile(); err != nil {
+		return i18n.WrapError(err)
+	}
+	if ctx.Verbose {
+		ctx.GetLogger().Println(constants.LOG_LEVEL_INFO, "Compilation database saved in {0}", ctx.CompilationDatabase.File)
+	}
+	return nil
+}
@@ -97,13 +97,19 @@ func compileCore(ctx *types.Context, buildPath *paths.Path, buildCachePath *path
 	realCoreFolder := coreFolder.Parent().Parent()
 
 	var targetArchivedCore *paths.Path
-	if buildCachePath != nil {
+	// the cached core is skipped, to record the commands of the core files in
+	// the compilation database
+	if buildCachePath != nil && !ctx.OnlyUpdateCompilationDatabase {
 		archivedCoreName := builder_utils.GetCachedCoreArchiveFileName(buildProperties.Get(constants.BUILD_PROPERTIES_FQBN), realCoreFolder)
 		targetArchivedCore = buildCachePath.Join(archivedCoreName)
 		canUseArchivedCore := !builder_utils.CoreOrReferencedCoreHasChanged(realCoreFolder, targetCoreFolder, targetArchivedCore)
 
 		if canUseArchivedCore {
-			// use archived core
+			// use archived core, the core files are not compiled but their
+			// commands are still recorded in the compilation database
+			if err := builder_utils.AddFilesToCompilationDatabase(ctx, coreFolder, true, buildPath, buildProperties, includes); err != nil {
+				return nil, nil, i18n.WrapError(err)
+			}
 			if ctx.Verbose {
 				logger.Println(constants.LOG_LEVEL_INFO, "Using precompiled core: {0}", targetArchivedCore)
 			}
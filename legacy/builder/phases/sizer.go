@@ -88,11 +88,18 @@ func checkSize(ctx *types.Context, buildProperties *properties.Map) error {
 		}
 	}
 
-	textSize, dataSize, _, err := execSizeRecipe(ctx, properties)
+	textSize, dataSize, eepromSize, err := execSizeRecipe(ctx, properties)
 	if err != nil {
 		logger.Println(constants.LOG_LEVEL_WARN, constants.MSG_SIZER_ERROR_NO_RULE)
 		return nil
 	}
+	ctx.ExecutableSize = &types.ExecutableSize{
+		TextSize:    textSize,
+		MaxTextSize: maxTextSize,
+		DataSize:    dataSize,
+		MaxDataSize: maxDataSize,
+		EepromSize:  eepromSize,
+	}
 
 	logger.Println(constants.LOG_LEVEL_INFO, constants.MSG_SIZER_TEXT_FULL, strconv.Itoa(textSize), strconv.Itoa(maxTextSize), strconv.Itoa(textSize*100/maxTextSize))
 	if dataSize >= 0 {
@@ -3,7 +3,9 @@ package types
 import (
 	"io"
 	"strings"
+	"sync"
 
+	"github.com/arduino/arduino-cli/arduino/builder"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/arduino/libraries"
@@ -64,6 +66,21 @@ type Context struct {
 	PreprocPath          *paths.Path
 	SketchObjectFiles    paths.PathList
 
+	// Size of the compiled sketch, set by the Sizer
+	ExecutableSize *ExecutableSize
+
+	// Diagnostics emitted by the compiler, see AddCompilerDiagnostics
+	CompilerDiagnostics    []*builder.Diagnostic
+	compilerDiagnosticsMux sync.Mutex
+
+	// The commands used to compile the source files, saved as
+	// compile_commands.json in the build path
+	CompilationDatabase *builder.CompilationDatabase
+	// Record the compile commands without running them
+	OnlyUpdateCompilationDatabase bool
+	// Object files cache shared across build paths, nil to disable it
+	ObjectCache *builder.ObjectCache
+
 	CollectedSourceFiles *UniqueSourceFileQueue
 
 	Sketch          *Sketch
@@ -118,6 +135,14 @@ type Context struct {
 	ExecStderr io.Writer
 }
 
+// AddCompilerDiagnostics adds the given diagnostics to CompilerDiagnostics,
+// it's safe to call it from the parallel compile jobs
+func (ctx *Context) AddCompilerDiagnostics(diagnostics []*builder.Diagnostic) {
+	ctx.compilerDiagnosticsMux.Lock()
+	defer ctx.compilerDiagnosticsMux.Unlock()
+	ctx.CompilerDiagnostics = append(ctx.CompilerDiagnostics, diagnostics...)
+}
+
 func (ctx *Context) ExtractBuildOptions() *properties.Map {
 	opts := properties.NewMap()
 	opts.Set("hardwareFolders", strings.Join(ctx.HardwareDirs.AsStrings(), ","))
@@ -222,6 +222,16 @@ type CTag struct {
 	PrototypeModifiers string
 }
 
+// ExecutableSize contains the sizes of the compiled sketch computed by the
+// size recipe and the maximum sizes allowed by the board, -1 if unknown
+type ExecutableSize struct {
+	TextSize    int
+	MaxTextSize int
+	DataSize    int
+	MaxDataSize int
+	EepromSize  int
+}
+
 type Command interface {
 	Run(ctx *Context) error
 }
@@ -71,6 +71,7 @@ type BoardDetailsResp struct {
 	Name                 string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	ConfigOptions        []*ConfigOption `protobuf:"bytes,3,rep,name=config_options,json=configOptions,proto3" json:"config_options,omitempty"`
 	RequiredTools        []*RequiredTool `protobuf:"bytes,4,rep,name=required_tools,json=requiredTools,proto3" json:"required_tools,omitempty"`
+	Programmers          []*Programmer   `protobuf:"bytes,5,rep,name=programmers,proto3" json:"programmers,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -122,6 +123,13 @@ func (m *BoardDetailsResp) GetRequiredTools() []*RequiredTool {
 	return nil
 }
 
+func (m *BoardDetailsResp) GetProgrammers() []*Programmer {
+	if m != nil {
+		return m.Programmers
+	}
+	return nil
+}
+
 type ConfigOption struct {
 	Option               string         `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
 	OptionLabel          string         `protobuf:"bytes,2,opt,name=option_label,json=optionLabel,proto3" json:"option_label,omitempty"`
@@ -287,6 +295,61 @@ func (m *RequiredTool) GetPackager() string {
 	return ""
 }
 
+type Programmer struct {
+	Platform             string   `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Programmer) Reset()         { *m = Programmer{} }
+func (m *Programmer) String() string { return proto.CompactTextString(m) }
+func (*Programmer) ProtoMessage()    {}
+func (*Programmer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0882eeddaa6507ab, []int{5}
+}
+
+func (m *Programmer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Programmer.Unmarshal(m, b)
+}
+func (m *Programmer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Programmer.Marshal(b, m, deterministic)
+}
+func (m *Programmer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Programmer.Merge(m, src)
+}
+func (m *Programmer) XXX_Size() int {
+	return xxx_messageInfo_Programmer.Size(m)
+}
+func (m *Programmer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Programmer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Programmer proto.InternalMessageInfo
+
+func (m *Programmer) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+func (m *Programmer) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Programmer) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 type BoardAttachReq struct {
 	Instance             *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
 	BoardUri             string    `protobuf:"bytes,2,opt,name=board_uri,json=boardUri,proto3" json:"board_uri,omitempty"`
@@ -301,7 +364,7 @@ func (m *BoardAttachReq) Reset()         { *m = BoardAttachReq{} }
 func (m *BoardAttachReq) String() string { return proto.CompactTextString(m) }
 func (*BoardAttachReq) ProtoMessage()    {}
 func (*BoardAttachReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0882eeddaa6507ab, []int{5}
+	return fileDescriptor_0882eeddaa6507ab, []int{6}
 }
 
 func (m *BoardAttachReq) XXX_Unmarshal(b []byte) error {
@@ -361,7 +424,7 @@ func (m *BoardAttachResp) Reset()         { *m = BoardAttachResp{} }
 func (m *BoardAttachResp) String() string { return proto.CompactTextString(m) }
 func (*BoardAttachResp) ProtoMessage()    {}
 func (*BoardAttachResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0882eeddaa6507ab, []int{6}
+	return fileDescriptor_0882eeddaa6507ab, []int{7}
 }
 
 func (m *BoardAttachResp) XXX_Unmarshal(b []byte) error {
@@ -400,7 +463,7 @@ func (m *BoardListReq) Reset()         { *m = BoardListReq{} }
 func (m *BoardListReq) String() string { return proto.CompactTextString(m) }
 func (*BoardListReq) ProtoMessage()    {}
 func (*BoardListReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0882eeddaa6507ab, []int{7}
+	return fileDescriptor_0882eeddaa6507ab, []int{8}
 }
 
 func (m *BoardListReq) XXX_Unmarshal(b []byte) error {
@@ -439,7 +502,7 @@ func (m *BoardListResp) Reset()         { *m = BoardListResp{} }
 func (m *BoardListResp) String() string { return proto.CompactTextString(m) }
 func (*BoardListResp) ProtoMessage()    {}
 func (*BoardListResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0882eeddaa6507ab, []int{8}
+	return fileDescriptor_0882eeddaa6507ab, []int{9}
 }
 
 func (m *BoardListResp) XXX_Unmarshal(b []byte) error {
@@ -467,6 +530,101 @@ func (m *BoardListResp) GetPorts() []*DetectedPort {
 	return nil
 }
 
+type BoardListWatchReq struct {
+	Instance             *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *BoardListWatchReq) Reset()         { *m = BoardListWatchReq{} }
+func (m *BoardListWatchReq) String() string { return proto.CompactTextString(m) }
+func (*BoardListWatchReq) ProtoMessage()    {}
+func (*BoardListWatchReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0882eeddaa6507ab, []int{10}
+}
+
+func (m *BoardListWatchReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BoardListWatchReq.Unmarshal(m, b)
+}
+func (m *BoardListWatchReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BoardListWatchReq.Marshal(b, m, deterministic)
+}
+func (m *BoardListWatchReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoardListWatchReq.Merge(m, src)
+}
+func (m *BoardListWatchReq) XXX_Size() int {
+	return xxx_messageInfo_BoardListWatchReq.Size(m)
+}
+func (m *BoardListWatchReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoardListWatchReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoardListWatchReq proto.InternalMessageInfo
+
+func (m *BoardListWatchReq) GetInstance() *Instance {
+	if m != nil {
+		return m.Instance
+	}
+	return nil
+}
+
+type BoardListWatchResp struct {
+	// Either "add" or "remove"
+	EventType            string        `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Port                 *DetectedPort `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	Error                string        `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BoardListWatchResp) Reset()         { *m = BoardListWatchResp{} }
+func (m *BoardListWatchResp) String() string { return proto.CompactTextString(m) }
+func (*BoardListWatchResp) ProtoMessage()    {}
+func (*BoardListWatchResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0882eeddaa6507ab, []int{11}
+}
+
+func (m *BoardListWatchResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BoardListWatchResp.Unmarshal(m, b)
+}
+func (m *BoardListWatchResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BoardListWatchResp.Marshal(b, m, deterministic)
+}
+func (m *BoardListWatchResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoardListWatchResp.Merge(m, src)
+}
+func (m *BoardListWatchResp) XXX_Size() int {
+	return xxx_messageInfo_BoardListWatchResp.Size(m)
+}
+func (m *BoardListWatchResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoardListWatchResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoardListWatchResp proto.InternalMessageInfo
+
+func (m *BoardListWatchResp) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
+}
+
+func (m *BoardListWatchResp) GetPort() *DetectedPort {
+	if m != nil {
+		return m.Port
+	}
+	return nil
+}
+
+func (m *BoardListWatchResp) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type DetectedPort struct {
 	Address              string           `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Protocol             string           `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
@@ -481,7 +639,7 @@ func (m *DetectedPort) Reset()         { *m = DetectedPort{} }
 func (m *DetectedPort) String() string { return proto.CompactTextString(m) }
 func (*DetectedPort) ProtoMessage()    {}
 func (*DetectedPort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0882eeddaa6507ab, []int{9}
+	return fileDescriptor_0882eeddaa6507ab, []int{12}
 }
 
 func (m *DetectedPort) XXX_Unmarshal(b []byte) error {
@@ -542,7 +700,7 @@ func (m *BoardListAllReq) Reset()         { *m = BoardListAllReq{} }
 func (m *BoardListAllReq) String() string { return proto.CompactTextString(m) }
 func (*BoardListAllReq) ProtoMessage()    {}
 func (*BoardListAllReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0882eeddaa6507ab, []int{10}
+	return fileDescriptor_0882eeddaa6507ab, []int{13}
 }
 
 func (m *BoardListAllReq) XXX_Unmarshal(b []byte) error {
@@ -588,7 +746,7 @@ func (m *BoardListAllResp) Reset()         { *m = BoardListAllResp{} }
 func (m *BoardListAllResp) String() string { return proto.CompactTextString(m) }
 func (*BoardListAllResp) ProtoMessage()    {}
 func (*BoardListAllResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0882eeddaa6507ab, []int{11}
+	return fileDescriptor_0882eeddaa6507ab, []int{14}
 }
 
 func (m *BoardListAllResp) XXX_Unmarshal(b []byte) error {
@@ -628,7 +786,7 @@ func (m *BoardListItem) Reset()         { *m = BoardListItem{} }
 func (m *BoardListItem) String() string { return proto.CompactTextString(m) }
 func (*BoardListItem) ProtoMessage()    {}
 func (*BoardListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0882eeddaa6507ab, []int{12}
+	return fileDescriptor_0882eeddaa6507ab, []int{15}
 }
 
 func (m *BoardListItem) XXX_Unmarshal(b []byte) error {
@@ -669,10 +827,13 @@ func init() {
 	proto.RegisterType((*ConfigOption)(nil), "cc.arduino.cli.commands.ConfigOption")
 	proto.RegisterType((*ConfigValue)(nil), "cc.arduino.cli.commands.ConfigValue")
 	proto.RegisterType((*RequiredTool)(nil), "cc.arduino.cli.commands.RequiredTool")
+	proto.RegisterType((*Programmer)(nil), "cc.arduino.cli.commands.Programmer")
 	proto.RegisterType((*BoardAttachReq)(nil), "cc.arduino.cli.commands.BoardAttachReq")
 	proto.RegisterType((*BoardAttachResp)(nil), "cc.arduino.cli.commands.BoardAttachResp")
 	proto.RegisterType((*BoardListReq)(nil), "cc.arduino.cli.commands.BoardListReq")
 	proto.RegisterType((*BoardListResp)(nil), "cc.arduino.cli.commands.BoardListResp")
+	proto.RegisterType((*BoardListWatchReq)(nil), "cc.arduino.cli.commands.BoardListWatchReq")
+	proto.RegisterType((*BoardListWatchResp)(nil), "cc.arduino.cli.commands.BoardListWatchResp")
 	proto.RegisterType((*DetectedPort)(nil), "cc.arduino.cli.commands.DetectedPort")
 	proto.RegisterType((*BoardListAllReq)(nil), "cc.arduino.cli.commands.BoardListAllReq")
 	proto.RegisterType((*BoardListAllResp)(nil), "cc.arduino.cli.commands.BoardListAllResp")
@@ -682,46 +843,53 @@ func init() {
 func init() { proto.RegisterFile("commands/board.proto", fileDescriptor_0882eeddaa6507ab) }
 
 var fileDescriptor_0882eeddaa6507ab = []byte{
-	// 656 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xdd, 0x6e, 0x13, 0x3b,
-	0x10, 0xd6, 0x36, 0x69, 0x4e, 0x32, 0x49, 0x7a, 0x8e, 0xac, 0x9e, 0x73, 0x56, 0xe5, 0x82, 0x74,
-	0x45, 0x51, 0x24, 0xd4, 0x44, 0x2a, 0x17, 0x5c, 0xf0, 0x23, 0xb5, 0x54, 0x48, 0x45, 0x01, 0x8a,
-	0x55, 0x10, 0x42, 0x42, 0x8b, 0xe3, 0x75, 0x13, 0x2b, 0x9b, 0xf5, 0xc6, 0x76, 0xfa, 0x0a, 0x3c,
-	0x0c, 0xb7, 0x3c, 0x01, 0x4f, 0x86, 0xfc, 0xb7, 0xda, 0x4a, 0x84, 0x4a, 0xd0, 0xab, 0x9d, 0x99,
-	0xfd, 0x3c, 0xf3, 0xcd, 0xcc, 0x67, 0xc3, 0x2e, 0x15, 0xcb, 0x25, 0x29, 0x32, 0x35, 0x9e, 0x0a,
-	0x22, 0xb3, 0x51, 0x29, 0x85, 0x16, 0xe8, 0x7f, 0x4a, 0x47, 0x44, 0x66, 0x6b, 0x5e, 0x88, 0x11,
-	0xcd, 0xf9, 0x28, 0x80, 0xf6, 0xfe, 0xad, 0xe0, 0xc6, 0x10, 0x85, 0xc3, 0x27, 0x19, 0xfc, 0x7d,
-	0x62, 0x8e, 0x9f, 0x32, 0x4d, 0x78, 0xae, 0x30, 0x5b, 0xa1, 0xa7, 0xd0, 0xe6, 0x85, 0xd2, 0xa4,
-	0xa0, 0x2c, 0x8e, 0x06, 0xd1, 0xb0, 0x7b, 0xb4, 0x3f, 0xda, 0x90, 0x75, 0x74, 0xe6, 0x81, 0xb8,
-	0x3a, 0x82, 0x10, 0x34, 0x2f, 0x57, 0xd3, 0x22, 0xde, 0x1a, 0x44, 0xc3, 0x0e, 0xb6, 0x76, 0xf2,
-	0x3d, 0x82, 0x7f, 0xae, 0x97, 0x51, 0xa5, 0x01, 0x16, 0x64, 0xc9, 0x02, 0xd0, 0xd8, 0x68, 0x02,
-	0x3b, 0x54, 0x14, 0x97, 0x7c, 0x96, 0x8a, 0x52, 0x73, 0x51, 0xa8, 0xb8, 0x31, 0x68, 0x0c, 0xbb,
-	0x47, 0x07, 0x1b, 0x19, 0x3c, 0xb7, 0xf0, 0x37, 0x16, 0x8d, 0xfb, 0xb4, 0xe6, 0x29, 0x93, 0x4d,
-	0xb2, 0xd5, 0x9a, 0x4b, 0x96, 0xa5, 0x5a, 0x88, 0x5c, 0xc5, 0xcd, 0x1b, 0xb2, 0x61, 0x0f, 0xbf,
-	0x10, 0x22, 0xc7, 0x7d, 0x59, 0xf3, 0x54, 0xf2, 0x25, 0x82, 0x5e, 0xbd, 0x1a, 0xfa, 0x0f, 0x5a,
-	0x8e, 0xa5, 0x1d, 0x53, 0x07, 0x7b, 0x0f, 0xed, 0x43, 0xcf, 0x59, 0x69, 0x4e, 0xa6, 0x2c, 0xf7,
-	0x0d, 0x76, 0x5d, 0x6c, 0x62, 0x42, 0xe8, 0x09, 0xb4, 0xae, 0x48, 0xbe, 0x66, 0xa1, 0xbf, 0x7b,
-	0x37, 0xf4, 0xf7, 0xde, 0x80, 0xb1, 0x3f, 0x93, 0x7c, 0x86, 0x6e, 0x2d, 0x8c, 0x76, 0x61, 0xdb,
-	0xfe, 0xf0, 0x34, 0x9c, 0x83, 0xee, 0x42, 0xd7, 0x1a, 0xd7, 0x48, 0x80, 0x0d, 0x39, 0x0e, 0x7b,
-	0xd0, 0x56, 0x2c, 0x67, 0x54, 0xb3, 0x2c, 0x6e, 0x0c, 0xa2, 0x61, 0x1b, 0x57, 0x7e, 0xf2, 0x01,
-	0x7a, 0xf5, 0x51, 0x54, 0xbb, 0x8a, 0x6a, 0xbb, 0x8a, 0xe1, 0xaf, 0x2b, 0x26, 0x95, 0xe9, 0xdf,
-	0x25, 0x0f, 0xae, 0xc9, 0x5c, 0x12, 0xba, 0x20, 0x33, 0x26, 0x6d, 0xe6, 0x0e, 0xae, 0xfc, 0xe4,
-	0x5b, 0x04, 0x3b, 0x56, 0x0a, 0xc7, 0x5a, 0x13, 0x3a, 0xbf, 0x05, 0xc1, 0xdd, 0x81, 0x8e, 0xbd,
-	0x01, 0xe9, 0x5a, 0x72, 0xcf, 0xa4, 0x6d, 0x03, 0xef, 0x24, 0x37, 0x53, 0x50, 0x0b, 0xa6, 0xe9,
-	0x3c, 0x2d, 0x89, 0x9e, 0x7b, 0x36, 0xe0, 0x42, 0xe7, 0x44, 0xcf, 0xd1, 0x01, 0xec, 0x28, 0x46,
-	0x24, 0x9d, 0xa7, 0x9a, 0x2f, 0x99, 0x58, 0xeb, 0xb8, 0x69, 0x31, 0x7d, 0x17, 0xbd, 0x70, 0xc1,
-	0xe4, 0x93, 0xbf, 0x27, 0x81, 0xb5, 0x2a, 0xd1, 0x4b, 0xe8, 0x6b, 0xa2, 0x16, 0x69, 0x29, 0xc5,
-	0x4c, 0x32, 0xa5, 0x3c, 0xf7, 0xcd, 0xe2, 0xba, 0x20, 0x6a, 0x71, 0xee, 0xc1, 0xb8, 0xa7, 0x6b,
-	0x5e, 0xf2, 0x0a, 0x7a, 0x36, 0xfd, 0x84, 0x2b, 0xfd, 0xe7, 0x23, 0x49, 0x26, 0xd0, 0xaf, 0xa5,
-	0x53, 0x25, 0x7a, 0x0c, 0xdb, 0xa5, 0x90, 0xda, 0x70, 0xfc, 0xf5, 0x05, 0x38, 0x65, 0xda, 0x2a,
-	0xe0, 0x5c, 0x48, 0x8d, 0xdd, 0x99, 0xe4, 0x6b, 0x04, 0xbd, 0x7a, 0xdc, 0x6c, 0x9e, 0x64, 0x59,
-	0xd5, 0x73, 0x07, 0x07, 0xd7, 0x6e, 0xde, 0xbc, 0x2b, 0x54, 0x04, 0xc5, 0x55, 0xbe, 0x99, 0x74,
-	0xb0, 0xbd, 0x26, 0xdd, 0x36, 0xfa, 0x21, 0xea, 0x64, 0xf9, 0x0c, 0x5a, 0x76, 0x7b, 0xe1, 0xb2,
-	0xde, 0xdf, 0xc8, 0xb5, 0x6a, 0xf1, 0x4c, 0xb3, 0x25, 0xf6, 0xa7, 0x92, 0x95, 0xdf, 0x94, 0xf9,
-	0x71, 0x9c, 0xe7, 0xb7, 0x20, 0x30, 0xa3, 0x21, 0x27, 0x11, 0x22, 0x67, 0x2a, 0xde, 0x1a, 0x34,
-	0xac, 0x86, 0x6c, 0xe8, 0x58, 0xce, 0x54, 0x82, 0xfd, 0xeb, 0x56, 0x95, 0x54, 0x65, 0xad, 0x8d,
-	0xe8, 0xb7, 0xda, 0x78, 0x54, 0x5b, 0xa1, 0xf9, 0xf1, 0xd3, 0x2b, 0x88, 0xa0, 0xf9, 0xe2, 0xed,
-	0xc9, 0xeb, 0xf0, 0x84, 0x1a, 0xfb, 0xe4, 0xf0, 0xe3, 0x83, 0x19, 0xd7, 0xf3, 0xf5, 0xd4, 0x54,
-	0x18, 0xfb, 0x8a, 0xe1, 0x7b, 0x48, 0x73, 0x3e, 0x96, 0x25, 0x1d, 0x87, 0xea, 0xd3, 0x96, 0x9d,
-	0xfe, 0xc3, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x88, 0xae, 0x33, 0x62, 0x4f, 0x06, 0x00, 0x00,
+	// 758 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x6d, 0x6b, 0x13, 0x41,
+	0x10, 0xe6, 0x92, 0x34, 0x26, 0x93, 0x97, 0xd6, 0xa5, 0xea, 0x51, 0x11, 0xd3, 0xd3, 0x4a, 0x40,
+	0x9a, 0x40, 0xfd, 0x20, 0xe2, 0x0b, 0xb4, 0x56, 0xa1, 0x12, 0x35, 0x2e, 0xf1, 0x05, 0x41, 0xce,
+	0xcd, 0xdd, 0x36, 0x39, 0x72, 0x77, 0x7b, 0xd9, 0xdd, 0x14, 0xfa, 0x5d, 0x10, 0x7f, 0x8b, 0x5f,
+	0xfd, 0x81, 0xb2, 0x7b, 0x7b, 0xd7, 0x8b, 0x18, 0x2b, 0xda, 0x4f, 0x99, 0x99, 0x7b, 0xe6, 0x99,
+	0x67, 0x67, 0x67, 0x36, 0xb0, 0xe9, 0xb1, 0x28, 0x22, 0xb1, 0x2f, 0xfa, 0x63, 0x46, 0xb8, 0xdf,
+	0x4b, 0x38, 0x93, 0x0c, 0x5d, 0xf3, 0xbc, 0x1e, 0xe1, 0xfe, 0x22, 0x88, 0x59, 0xcf, 0x0b, 0x83,
+	0x5e, 0x06, 0xda, 0xba, 0x92, 0xc3, 0x95, 0xc1, 0xe2, 0x14, 0xef, 0xf8, 0xb0, 0x7e, 0xa0, 0xd2,
+	0x0f, 0xa9, 0x24, 0x41, 0x28, 0x30, 0x9d, 0xa3, 0xc7, 0x50, 0x0b, 0x62, 0x21, 0x49, 0xec, 0x51,
+	0xdb, 0xea, 0x58, 0xdd, 0xc6, 0xde, 0x76, 0x6f, 0x05, 0x6b, 0xef, 0xc8, 0x00, 0x71, 0x9e, 0x82,
+	0x10, 0x54, 0x8e, 0xe7, 0xe3, 0xd8, 0x2e, 0x75, 0xac, 0x6e, 0x1d, 0x6b, 0xdb, 0xf9, 0x56, 0x82,
+	0x8d, 0xe5, 0x32, 0x22, 0x51, 0xc0, 0x98, 0x44, 0x34, 0x03, 0x2a, 0x1b, 0x0d, 0xa0, 0xed, 0xb1,
+	0xf8, 0x38, 0x98, 0xb8, 0x2c, 0x91, 0x01, 0x8b, 0x85, 0x5d, 0xee, 0x94, 0xbb, 0x8d, 0xbd, 0x9d,
+	0x95, 0x0a, 0x9e, 0x6a, 0xf8, 0x6b, 0x8d, 0xc6, 0x2d, 0xaf, 0xe0, 0x09, 0xc5, 0xc6, 0xe9, 0x7c,
+	0x11, 0x70, 0xea, 0xbb, 0x92, 0xb1, 0x50, 0xd8, 0x95, 0x73, 0xd8, 0xb0, 0x81, 0x8f, 0x18, 0x0b,
+	0x71, 0x8b, 0x17, 0x3c, 0x81, 0x9e, 0x41, 0x23, 0xe1, 0x6c, 0xc2, 0x49, 0x14, 0x51, 0x2e, 0xec,
+	0x35, 0x4d, 0x75, 0x6b, 0x25, 0xd5, 0x30, 0xc7, 0xe2, 0x62, 0x9e, 0xf3, 0xd5, 0x82, 0x66, 0x51,
+	0x34, 0xba, 0x0a, 0xd5, 0xf4, 0xb0, 0xba, 0xdb, 0x75, 0x6c, 0x3c, 0xb4, 0x0d, 0xcd, 0xd4, 0x72,
+	0x43, 0x32, 0xa6, 0xa1, 0xe9, 0x53, 0x23, 0x8d, 0x0d, 0x54, 0x08, 0x3d, 0x82, 0xea, 0x09, 0x09,
+	0x17, 0x34, 0x6b, 0xd3, 0xed, 0x73, 0xda, 0xf4, 0x4e, 0x81, 0xb1, 0xc9, 0x71, 0x3e, 0x43, 0xa3,
+	0x10, 0x46, 0x9b, 0xb0, 0xa6, 0x3f, 0x18, 0x19, 0xa9, 0x83, 0x6e, 0x42, 0x43, 0x1b, 0x4b, 0x22,
+	0x40, 0x87, 0x52, 0x0d, 0x5b, 0x50, 0x13, 0x34, 0xa4, 0x9e, 0xa4, 0xbe, 0x5d, 0xee, 0x58, 0xdd,
+	0x1a, 0xce, 0x7d, 0xe7, 0x03, 0x34, 0x8b, 0x1d, 0xcd, 0xaf, 0xdc, 0x2a, 0x5c, 0xb9, 0x0d, 0x97,
+	0x4e, 0x28, 0x17, 0xea, 0xfc, 0x29, 0x79, 0xe6, 0x2a, 0xe6, 0x84, 0x78, 0x33, 0x32, 0xa1, 0x5c,
+	0x33, 0xd7, 0x71, 0xee, 0x3b, 0x03, 0x80, 0xb3, 0x06, 0x6b, 0x64, 0x48, 0xe4, 0x31, 0xe3, 0x91,
+	0xe1, 0xce, 0x7d, 0xd4, 0x86, 0x52, 0xe0, 0x1b, 0xea, 0x52, 0xe0, 0xe7, 0x1a, 0xca, 0x67, 0x1a,
+	0x9c, 0x1f, 0x16, 0xb4, 0xf5, 0x7c, 0xee, 0x4b, 0x49, 0xbc, 0xe9, 0x05, 0x6c, 0xc1, 0x75, 0xa8,
+	0xeb, 0xb5, 0x74, 0x17, 0x3c, 0x30, 0xc5, 0x6b, 0x3a, 0xf0, 0x96, 0x07, 0xaa, 0xa7, 0x62, 0x46,
+	0xa5, 0x37, 0x75, 0x13, 0x22, 0xa7, 0x46, 0x09, 0xa4, 0xa1, 0x21, 0x91, 0x53, 0xb4, 0x03, 0x6d,
+	0x41, 0x09, 0xf7, 0xa6, 0xae, 0x0c, 0x22, 0xca, 0x16, 0xd2, 0xae, 0x68, 0x4c, 0x2b, 0x8d, 0x8e,
+	0xd2, 0xa0, 0xf3, 0x09, 0xd6, 0x97, 0x54, 0x8b, 0x04, 0xbd, 0x80, 0x96, 0x24, 0x62, 0xe6, 0xea,
+	0x89, 0xa3, 0x42, 0x18, 0xed, 0xab, 0x27, 0x7e, 0x44, 0xc4, 0x6c, 0x68, 0xc0, 0xb8, 0x29, 0x0b,
+	0x9e, 0xf3, 0x12, 0x9a, 0x9a, 0x7e, 0x10, 0x08, 0xf9, 0xff, 0x2d, 0x71, 0x06, 0xd0, 0x2a, 0xd0,
+	0x89, 0x04, 0x3d, 0x84, 0xb5, 0x84, 0x71, 0xa9, 0x34, 0xfe, 0x79, 0x2b, 0x0f, 0xa9, 0xd4, 0xf3,
+	0x34, 0x64, 0x5c, 0xe2, 0x34, 0xc7, 0xc1, 0x70, 0x39, 0x67, 0x7b, 0x4f, 0xe4, 0x45, 0x5c, 0x9a,
+	0xf3, 0xc5, 0x02, 0xf4, 0x2b, 0xa9, 0x48, 0xd0, 0x0d, 0x00, 0x7a, 0x42, 0x63, 0xe9, 0xca, 0xd3,
+	0x24, 0x9b, 0xdd, 0xba, 0x8e, 0x8c, 0x4e, 0x13, 0x8a, 0x1e, 0x40, 0x45, 0x49, 0xd2, 0xb7, 0xfc,
+	0xd7, 0xa7, 0xd0, 0x29, 0x6a, 0xe5, 0x28, 0xe7, 0x2c, 0x1b, 0xef, 0xd4, 0x71, 0xbe, 0x5b, 0xd0,
+	0x2c, 0x82, 0xd5, 0x8a, 0x10, 0xdf, 0xcf, 0xaf, 0xb3, 0x8e, 0x33, 0x57, 0x0f, 0xbe, 0x7a, 0xc7,
+	0x3d, 0x96, 0xad, 0x66, 0xee, 0xab, 0x21, 0xca, 0x6c, 0xb3, 0xbc, 0x69, 0x95, 0x56, 0x16, 0x4d,
+	0xf7, 0xf7, 0x09, 0x54, 0xf5, 0x60, 0x66, 0x8f, 0xe3, 0x9d, 0x95, 0x07, 0xc8, 0x5b, 0x73, 0x24,
+	0x69, 0x84, 0x4d, 0x96, 0x33, 0x87, 0xf5, 0xfc, 0xc3, 0x7e, 0x18, 0x5e, 0xc0, 0xee, 0xa8, 0xf5,
+	0x48, 0xa7, 0x9f, 0xf0, 0x89, 0xb0, 0x4b, 0x9d, 0xb2, 0x5e, 0x0f, 0x1d, 0xda, 0xe7, 0x13, 0x75,
+	0xf7, 0x1b, 0xcb, 0x25, 0x45, 0x52, 0x38, 0x86, 0xf5, 0x4f, 0xc7, 0xb8, 0x0f, 0xad, 0xa5, 0x0f,
+	0xbf, 0x7d, 0xab, 0x10, 0x54, 0x9e, 0xbf, 0x39, 0x78, 0x95, 0xfd, 0x65, 0x29, 0xfb, 0x60, 0xf7,
+	0xe3, 0xdd, 0x49, 0x20, 0xa7, 0x8b, 0xb1, 0xaa, 0xd0, 0x37, 0x15, 0xb3, 0xdf, 0x5d, 0x2f, 0x0c,
+	0xfa, 0x3c, 0xf1, 0xfa, 0x59, 0xf5, 0x71, 0x55, 0x77, 0xff, 0xde, 0xcf, 0x01, 0x00, 0xf3, 0x5e,
+	0x0b, 0x00, 0xbf, 0x07, 0x00, 0x00,
 }
@@ -615,70 +615,77 @@ func init() {
 func init() { proto.RegisterFile("commands/commands.proto", fileDescriptor_3690061a1131852d) }
 
 var fileDescriptor_3690061a1131852d = []byte{
-	// 1000 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xcd, 0x53, 0x1b, 0x37,
-	0x14, 0xc0, 0x63, 0x92, 0xf2, 0xf1, 0x8c, 0x49, 0x22, 0x48, 0xf0, 0xf8, 0x44, 0x36, 0x1f, 0x18,
-	0x28, 0x86, 0xd2, 0x5e, 0xdb, 0x19, 0x07, 0x7a, 0x20, 0xa5, 0x43, 0xc6, 0xd4, 0x4c, 0x27, 0x17,
-	0x57, 0xde, 0x15, 0x46, 0xe3, 0x65, 0x25, 0xa4, 0x25, 0xad, 0x4f, 0x3d, 0xf7, 0x5f, 0xe9, 0xb5,
-	0x7f, 0x4c, 0xff, 0x9d, 0x8e, 0xb4, 0x92, 0xbc, 0x0b, 0xec, 0x07, 0x85, 0x9c, 0x40, 0xef, 0xfd,
-	0xde, 0x7b, 0xd2, 0xfb, 0xb2, 0x0d, 0xab, 0x3e, 0xbb, 0xb8, 0xc0, 0x51, 0x20, 0x77, 0xec, 0x3f,
-	0x1d, 0x2e, 0x58, 0xcc, 0xd0, 0xaa, 0xef, 0x77, 0xb0, 0x08, 0xae, 0x68, 0xc4, 0x3a, 0x7e, 0x48,
-	0x3b, 0x56, 0xdd, 0x7a, 0x91, 0xb1, 0x60, 0x51, 0xc2, 0xb7, 0x56, 0x9c, 0x78, 0xc8, 0xb0, 0x08,
-	0x8c, 0xf4, 0x65, 0x1a, 0xe6, 0x34, 0x24, 0x46, 0xbe, 0x9c, 0x92, 0x0b, 0x2b, 0x9c, 0x7a, 0xbe,
-	0xe2, 0x21, 0xc3, 0xd6, 0x07, 0x72, 0xe2, 0x90, 0x0e, 0x13, 0x99, 0xf7, 0x4f, 0x0d, 0x1a, 0xfb,
-	0x2c, 0x3a, 0xa3, 0xa3, 0x2b, 0x81, 0x63, 0xca, 0x22, 0xd4, 0x84, 0xb9, 0x00, 0xc7, 0xf8, 0x80,
-	0x8a, 0x66, 0x6d, 0xad, 0xd6, 0x5e, 0xe8, 0xd9, 0x23, 0x7a, 0x03, 0x0d, 0x39, 0x26, 0xb1, 0x7f,
-	0x3e, 0x64, 0x6c, 0xac, 0xf4, 0x33, 0x5a, 0x9f, 0x15, 0x22, 0x0f, 0x16, 0x03, 0xf6, 0x7b, 0xa4,
-	0xe2, 0x4a, 0x05, 0x3d, 0xd6, 0x50, 0x46, 0x86, 0x7e, 0x80, 0x96, 0x7e, 0xdc, 0xcf, 0x38, 0xc2,
-	0x23, 0x22, 0xba, 0x41, 0x40, 0x55, 0x6c, 0x1c, 0xf6, 0x45, 0x28, 0x9b, 0x4f, 0xd6, 0x1e, 0xb7,
-	0x17, 0x7a, 0x05, 0x84, 0xf7, 0x57, 0x0d, 0xe6, 0x0e, 0x23, 0x1a, 0xf7, 0xc8, 0x25, 0x3a, 0x82,
-	0x86, 0x9f, 0x7e, 0x80, 0xbe, 0x75, 0x7d, 0xef, 0x5d, 0x27, 0x27, 0xef, 0x9d, 0xcc, 0x73, 0x7b,
-	0x59, 0x63, 0xb4, 0x0b, 0x2b, 0x21, 0x1d, 0x0a, 0x2c, 0x26, 0x83, 0x8b, 0x24, 0xf4, 0x80, 0x45,
-	0xe1, 0x44, 0x3f, 0x75, 0xbe, 0x87, 0x8c, 0xce, 0xdc, 0xea, 0x38, 0x0a, 0x27, 0xde, 0xbf, 0x33,
-	0x30, 0x9f, 0xdc, 0x45, 0x72, 0xf4, 0x3d, 0xcc, 0xd3, 0x48, 0xc6, 0x38, 0xf2, 0x89, 0xb9, 0xc7,
-	0xab, 0xdc, 0x7b, 0x1c, 0x1a, 0xb0, 0xe7, 0x4c, 0xd0, 0x77, 0xf0, 0x92, 0x87, 0x38, 0x3e, 0x63,
-	0xe2, 0x42, 0x0e, 0x68, 0x14, 0x90, 0x3f, 0x06, 0x44, 0x08, 0x26, 0x64, 0x73, 0x46, 0xe7, 0x64,
-	0xc5, 0x69, 0x0f, 0x95, 0xf2, 0x47, 0xad, 0x43, 0x7b, 0xf0, 0x22, 0xb9, 0x17, 0x25, 0x19, 0x2b,
-	0x93, 0xfa, 0x65, 0xa7, 0x9c, 0x1a, 0xa1, 0x53, 0x78, 0x6e, 0x2b, 0x32, 0xe0, 0x82, 0x8d, 0x04,
-	0x91, 0x2a, 0xf1, 0xea, 0xc6, 0x1b, 0xb9, 0x37, 0x3e, 0x30, 0x16, 0x1f, 0x8d, 0x41, 0xef, 0x59,
-	0x70, 0x4d, 0x82, 0x3e, 0x40, 0x23, 0xc6, 0x72, 0x3c, 0xf5, 0xf9, 0x95, 0xf6, 0xf9, 0x36, 0xd7,
-	0xe7, 0x2f, 0x58, 0x8e, 0x9d, 0xbf, 0xc5, 0x38, 0x75, 0xf2, 0x7e, 0x02, 0x38, 0x20, 0x32, 0x16,
-	0x6c, 0xa2, 0xea, 0x7c, 0xbf, 0xd4, 0x7a, 0x0d, 0xa8, 0x3b, 0x67, 0x92, 0x7b, 0x1f, 0x60, 0xa1,
-	0x47, 0xa4, 0x8f, 0xa3, 0x07, 0x70, 0xfd, 0x19, 0xc0, 0xfa, 0x92, 0xbc, 0xa0, 0x86, 0xb5, 0xff,
-	0x53, 0xc3, 0x99, 0xdc, 0x1a, 0x7a, 0xc7, 0xb0, 0xd4, 0xe7, 0x01, 0x8e, 0x89, 0x96, 0x3d, 0xc0,
-	0x43, 0x28, 0x3c, 0xcd, 0x38, 0x94, 0xfc, 0xf6, 0x3e, 0xa9, 0xdd, 0xbb, 0x4f, 0xbc, 0x5f, 0x61,
-	0x35, 0x09, 0x75, 0x94, 0x79, 0xd8, 0x03, 0x3c, 0x42, 0x40, 0xf3, 0x76, 0xcf, 0x5f, 0xf0, 0x35,
-	0x8b, 0x00, 0xa7, 0x44, 0x48, 0xb5, 0x4f, 0xc8, 0xa5, 0xb7, 0x0e, 0x75, 0x77, 0x92, 0x5c, 0x2d,
-	0xd4, 0xcf, 0xc9, 0xd1, 0x2e, 0x54, 0x73, 0xdc, 0xfb, 0x7b, 0x19, 0xea, 0xdd, 0x24, 0xe4, 0x3e,
-	0x13, 0x04, 0x1d, 0xc3, 0x13, 0xb5, 0x49, 0xd0, 0x5a, 0xc1, 0x7b, 0xf5, 0xd2, 0x6b, 0xbd, 0x2a,
-	0x21, 0x24, 0xf7, 0x1e, 0xed, 0xd6, 0xd0, 0x29, 0xcc, 0x99, 0xa6, 0x47, 0xaf, 0xf3, 0xdf, 0xe7,
-	0x66, 0xac, 0xf5, 0xa6, 0x1c, 0x52, 0x9e, 0xd1, 0x09, 0xcc, 0x26, 0x1d, 0x8f, 0xbc, 0x5c, 0x0b,
-	0x37, 0x5e, 0xad, 0xd7, 0xa5, 0x8c, 0x76, 0x1a, 0x40, 0x3d, 0xd5, 0x7d, 0x68, 0x3d, 0xd7, 0x2a,
-	0xdb, 0xf4, 0xad, 0x76, 0x35, 0xd0, 0xa4, 0xe4, 0x4f, 0x58, 0xb9, 0xad, 0x3d, 0xd0, 0x6e, 0x89,
-	0x97, 0x1b, 0x7d, 0xda, 0xfa, 0xe6, 0x8e, 0x16, 0xd3, 0x9a, 0x98, 0xee, 0x28, 0xa8, 0xc9, 0xb4,
-	0x9b, 0x0a, 0x6a, 0x92, 0x6a, 0x32, 0xef, 0x11, 0xf2, 0x61, 0xf1, 0xbd, 0xfa, 0xc4, 0x3c, 0x20,
-	0x31, 0xa6, 0xa1, 0x44, 0xf9, 0x69, 0x49, 0x63, 0x2a, 0xc2, 0x46, 0x45, 0x52, 0x72, 0x34, 0x84,
-	0xba, 0x96, 0x75, 0xe3, 0x18, 0xfb, 0xe7, 0x05, 0x35, 0x4a, 0x51, 0xc5, 0x35, 0xca, 0x80, 0x92,
-	0xef, 0xd6, 0xd0, 0x27, 0x58, 0xd0, 0xc2, 0x23, 0x2a, 0x63, 0xf4, 0xb6, 0xd8, 0x50, 0x31, 0xca,
-	0xff, 0xbb, 0x2a, 0x98, 0xe4, 0x2e, 0x49, 0x4a, 0xd0, 0x0d, 0xc3, 0xb2, 0x24, 0x19, 0xac, 0x42,
-	0x92, 0x1c, 0xa9, 0xb7, 0xcc, 0xdc, 0x7e, 0xf2, 0x25, 0xad, 0xa0, 0xc2, 0x86, 0x28, 0xae, 0xb0,
-	0x83, 0x74, 0x62, 0x22, 0x78, 0xfa, 0xd1, 0x7c, 0x76, 0xe8, 0xbd, 0x17, 0x86, 0x68, 0x2b, 0xd7,
-	0xf4, 0x1a, 0xa9, 0xe2, 0x7c, 0x5d, 0x1d, 0xd6, 0xf1, 0x2e, 0xe1, 0x99, 0x55, 0xd8, 0x1d, 0x88,
-	0xca, 0x7d, 0x58, 0x54, 0x45, 0xdc, 0xbe, 0x03, 0xad, 0x43, 0xc6, 0xf0, 0xdc, 0x6a, 0xfa, 0x11,
-	0x35, 0x8f, 0x2c, 0xf7, 0xe2, 0x58, 0x15, 0xb4, 0x73, 0x17, 0xfc, 0x7a, 0x62, 0xfb, 0x7c, 0x24,
-	0x70, 0x40, 0x2a, 0x24, 0xd6, 0x90, 0xd5, 0x12, 0xeb, 0x60, 0x1d, 0xef, 0x04, 0x66, 0xfb, 0xfa,
-	0x8b, 0x79, 0xc1, 0xfa, 0x4c, 0x80, 0xe2, 0xf5, 0x69, 0x19, 0xed, 0x94, 0xc2, 0x92, 0x8d, 0x76,
-	0x42, 0xb0, 0xf0, 0xcf, 0xd1, 0x66, 0xe9, 0xb5, 0x12, 0x50, 0x05, 0xd9, 0xaa, 0xcc, 0x26, 0x53,
-	0x64, 0xa5, 0x7a, 0x48, 0xdb, 0xa5, 0xc6, 0x76, 0x4e, 0x37, 0x2a, 0x92, 0x92, 0xab, 0xa2, 0x24,
-	0x1b, 0x74, 0xe2, 0x9a, 0x2f, 0xff, 0x92, 0xd7, 0xc8, 0xe2, 0xa2, 0xdc, 0x80, 0x75, 0xfe, 0xc6,
-	0xb0, 0x64, 0x14, 0x76, 0xb8, 0x36, 0xcb, 0x3c, 0xa4, 0x66, 0x6b, 0xab, 0x32, 0x6b, 0x47, 0xcb,
-	0xc8, 0xa7, 0x6d, 0x5e, 0x7a, 0xe1, 0x4c, 0x97, 0x6f, 0xdf, 0x81, 0xb6, 0xa3, 0x65, 0x35, 0x49,
-	0x33, 0x76, 0x0b, 0x47, 0xeb, 0x06, 0x5b, 0x3c, 0x5a, 0xb7, 0xe0, 0x3a, 0xea, 0x19, 0x34, 0x8c,
-	0xca, 0x34, 0xe5, 0x46, 0x99, 0x8b, 0x69, 0x4f, 0x6e, 0x56, 0x45, 0x25, 0x47, 0xbf, 0x41, 0xdd,
-	0x08, 0x75, 0x47, 0xae, 0x97, 0x99, 0xda, 0x86, 0x6c, 0x57, 0x03, 0x25, 0x7f, 0xbf, 0xfd, 0x69,
-	0x6b, 0x44, 0xe3, 0xf3, 0xab, 0xa1, 0x42, 0x76, 0x8c, 0x89, 0xfd, 0xbb, 0xed, 0x87, 0x74, 0x47,
-	0x70, 0xdf, 0xfd, 0xf8, 0x1f, 0xce, 0xea, 0xdf, 0xd7, 0xdf, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff,
-	0x43, 0xa8, 0xcb, 0x4f, 0x18, 0x10, 0x00, 0x00,
+	// 1107 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0x4f, 0x53, 0xdc, 0x36,
+	0x14, 0xc0, 0xbb, 0x24, 0x0d, 0xf0, 0x96, 0x25, 0x89, 0x42, 0x02, 0xe3, 0x13, 0x71, 0x48, 0xf8,
+	0x57, 0x16, 0x4a, 0x3b, 0xed, 0xa9, 0x9d, 0xe1, 0x4f, 0x0f, 0xa4, 0x64, 0xc8, 0x2c, 0x85, 0x76,
+	0x72, 0xa1, 0x5a, 0x5b, 0x80, 0x06, 0x63, 0x09, 0x49, 0x4b, 0xcb, 0xa9, 0xe7, 0x1e, 0xfa, 0x69,
+	0xfa, 0x61, 0x7a, 0xea, 0x77, 0xe9, 0x48, 0x96, 0xbc, 0x36, 0x60, 0xd9, 0x24, 0xf4, 0x04, 0x7e,
+	0xef, 0xf7, 0xde, 0xd3, 0xfb, 0xa3, 0x67, 0x30, 0x4c, 0x47, 0xec, 0xfc, 0x1c, 0xa7, 0xb1, 0x5c,
+	0x75, 0xbf, 0x74, 0xb9, 0x60, 0x8a, 0xa1, 0xe9, 0x28, 0xea, 0x62, 0x11, 0x0f, 0x68, 0xca, 0xba,
+	0x51, 0x42, 0xbb, 0x4e, 0x1d, 0x3c, 0x2f, 0x59, 0xb0, 0x34, 0xe3, 0x83, 0xa9, 0x5c, 0xdc, 0x67,
+	0x58, 0xc4, 0x56, 0xfa, 0xa2, 0x08, 0x73, 0x9a, 0x10, 0x2b, 0x7f, 0x56, 0x90, 0x0b, 0x27, 0x1c,
+	0x7a, 0x1e, 0xf0, 0x84, 0x61, 0xe7, 0x03, 0xe5, 0xe2, 0x84, 0xf6, 0x33, 0x59, 0xf8, 0x77, 0x0b,
+	0x3a, 0x5b, 0x2c, 0x3d, 0xa6, 0x27, 0x03, 0x81, 0x15, 0x65, 0x29, 0x9a, 0x81, 0xd1, 0x18, 0x2b,
+	0xbc, 0x4d, 0xc5, 0x4c, 0x6b, 0xb6, 0xb5, 0x30, 0xde, 0x73, 0x8f, 0x68, 0x0e, 0x3a, 0xf2, 0x8c,
+	0xa8, 0xe8, 0xb4, 0xcf, 0xd8, 0x99, 0xd6, 0x8f, 0x18, 0x7d, 0x59, 0x88, 0x42, 0x98, 0x88, 0xd9,
+	0x6f, 0xa9, 0x8e, 0x2b, 0x35, 0xf4, 0xc0, 0x40, 0x25, 0x19, 0xfa, 0x1e, 0x02, 0x93, 0xdc, 0x3b,
+	0x9c, 0xe2, 0x13, 0x22, 0x36, 0xe2, 0x98, 0xea, 0xd8, 0x38, 0x39, 0x10, 0x89, 0x9c, 0x79, 0x38,
+	0xfb, 0x60, 0x61, 0xbc, 0xe7, 0x21, 0xc2, 0x3f, 0x5b, 0x30, 0xba, 0x93, 0x52, 0xd5, 0x23, 0x17,
+	0x68, 0x17, 0x3a, 0x51, 0x31, 0x01, 0x73, 0xea, 0xf6, 0xfa, 0x9b, 0x6e, 0x45, 0xdd, 0xbb, 0xa5,
+	0x74, 0x7b, 0x65, 0x63, 0xb4, 0x06, 0x53, 0x09, 0xed, 0x0b, 0x2c, 0xae, 0x8e, 0xce, 0xb3, 0xd0,
+	0x47, 0x2c, 0x4d, 0xae, 0x4c, 0xaa, 0x63, 0x3d, 0x64, 0x75, 0xf6, 0x54, 0x7b, 0x69, 0x72, 0x15,
+	0xfe, 0x33, 0x02, 0x63, 0xd9, 0x59, 0x24, 0x47, 0xdf, 0xc1, 0x18, 0x4d, 0xa5, 0xc2, 0x69, 0x44,
+	0xec, 0x39, 0x5e, 0x56, 0x9e, 0x63, 0xc7, 0x82, 0xbd, 0xdc, 0x04, 0x7d, 0x0d, 0x2f, 0x78, 0x82,
+	0xd5, 0x31, 0x13, 0xe7, 0xf2, 0x88, 0xa6, 0x31, 0xf9, 0xfd, 0x88, 0x08, 0xc1, 0x84, 0x9c, 0x19,
+	0x31, 0x35, 0x99, 0xca, 0xb5, 0x3b, 0x5a, 0xf9, 0x83, 0xd1, 0xa1, 0x75, 0x78, 0x9e, 0x9d, 0x8b,
+	0x92, 0x92, 0x95, 0x2d, 0xfd, 0xb3, 0x5c, 0x39, 0x34, 0x42, 0x87, 0xf0, 0xd4, 0x75, 0xe4, 0x88,
+	0x0b, 0x76, 0x22, 0x88, 0xd4, 0x85, 0xd7, 0x27, 0x5e, 0xac, 0x3c, 0xf1, 0xb6, 0xb5, 0x78, 0x6f,
+	0x0d, 0x7a, 0x4f, 0xe2, 0x6b, 0x12, 0xf4, 0x16, 0x3a, 0x0a, 0xcb, 0xb3, 0xa1, 0xcf, 0xcf, 0x8d,
+	0xcf, 0xd7, 0x95, 0x3e, 0x7f, 0xc2, 0xf2, 0x2c, 0xf7, 0x37, 0xa1, 0x0a, 0x4f, 0xe1, 0x8f, 0x00,
+	0xdb, 0x44, 0x2a, 0xc1, 0xae, 0x74, 0x9f, 0x3f, 0xad, 0xb4, 0x61, 0x07, 0xda, 0xb9, 0x33, 0xc9,
+	0xc3, 0xb7, 0x30, 0xde, 0x23, 0x32, 0xc2, 0xe9, 0x3d, 0xb8, 0xbe, 0x04, 0x70, 0xbe, 0x24, 0xf7,
+	0xf4, 0xb0, 0xf5, 0x31, 0x3d, 0x1c, 0xa9, 0xec, 0x61, 0xb8, 0x07, 0x93, 0x07, 0x3c, 0xc6, 0x8a,
+	0x18, 0xd9, 0x3d, 0x24, 0x42, 0xe1, 0x71, 0xc9, 0xa1, 0xe4, 0xb7, 0xcf, 0x49, 0xeb, 0x93, 0xe7,
+	0x24, 0xfc, 0x05, 0xa6, 0xb3, 0x50, 0xbb, 0xa5, 0xc4, 0xee, 0x21, 0x09, 0x01, 0x33, 0xb7, 0x7b,
+	0xfe, 0x1f, 0xb3, 0x99, 0x00, 0x38, 0x24, 0x42, 0xea, 0x7d, 0x42, 0x2e, 0xc2, 0x79, 0x68, 0xe7,
+	0x4f, 0x92, 0xeb, 0x85, 0x7a, 0x99, 0x3d, 0xba, 0x85, 0x6a, 0x1f, 0xd7, 0xff, 0x9d, 0x86, 0xf6,
+	0x46, 0x16, 0x72, 0x8b, 0x09, 0x82, 0xf6, 0xe0, 0xa1, 0xde, 0x24, 0x68, 0xd6, 0x93, 0xaf, 0x59,
+	0x7a, 0xc1, 0xcb, 0x1a, 0x42, 0xf2, 0xf0, 0xb3, 0xb5, 0x16, 0x3a, 0x84, 0x51, 0x3b, 0xf4, 0xe8,
+	0x55, 0x75, 0x7e, 0xf9, 0x1d, 0x0b, 0xe6, 0xea, 0x21, 0xed, 0x19, 0xed, 0xc3, 0xa3, 0x6c, 0xe2,
+	0x51, 0x58, 0x69, 0x91, 0x5f, 0xaf, 0xe0, 0x55, 0x2d, 0x63, 0x9c, 0xc6, 0xd0, 0x2e, 0x4c, 0x1f,
+	0x9a, 0xaf, 0xb4, 0x2a, 0x0f, 0x7d, 0xb0, 0xd0, 0x0c, 0xb4, 0x25, 0xf9, 0x03, 0xa6, 0x6e, 0x1b,
+	0x0f, 0xb4, 0x56, 0xe3, 0xe5, 0xc6, 0x9c, 0x06, 0x5f, 0xde, 0xd1, 0x62, 0xd8, 0x13, 0x3b, 0x1d,
+	0x9e, 0x9e, 0x0c, 0xa7, 0x29, 0x98, 0xab, 0x87, 0x4c, 0xf9, 0x22, 0x98, 0xd8, 0x64, 0x58, 0xc4,
+	0xdb, 0x44, 0x61, 0x9a, 0x48, 0x54, 0x5d, 0x96, 0x22, 0xa6, 0x23, 0x2c, 0x36, 0x24, 0x25, 0x47,
+	0x7d, 0x68, 0x1b, 0xd9, 0x86, 0x52, 0x38, 0x3a, 0xf5, 0xf4, 0xa8, 0x40, 0xf9, 0x7b, 0x54, 0x02,
+	0x25, 0x5f, 0x6b, 0xa1, 0x0f, 0x30, 0x6e, 0x84, 0xbb, 0x54, 0x2a, 0xf4, 0xda, 0x6f, 0xa8, 0x19,
+	0xed, 0xff, 0x4d, 0x13, 0x4c, 0x72, 0x74, 0x06, 0x93, 0xb9, 0xe0, 0x67, 0xac, 0xa2, 0x53, 0xb4,
+	0x54, 0x6f, 0x69, 0x40, 0x1d, 0x65, 0xb9, 0x31, 0x6b, 0x12, 0x71, 0x1d, 0xd1, 0xf2, 0x8d, 0x24,
+	0xa9, 0xeb, 0x88, 0xc5, 0x1a, 0x74, 0x24, 0x27, 0xcd, 0x4a, 0x1b, 0xdd, 0xca, 0xfe, 0x22, 0xf4,
+	0x8c, 0x93, 0x25, 0xfc, 0xe3, 0x94, 0x43, 0xee, 0xf0, 0x5b, 0x2c, 0x26, 0x5a, 0x98, 0x10, 0x45,
+	0x3c, 0x87, 0x2f, 0x62, 0xfe, 0xc3, 0x97, 0x49, 0xc9, 0x51, 0x0a, 0x8f, 0xdf, 0xdb, 0xb7, 0xa1,
+	0xd9, 0xe4, 0x49, 0x82, 0xaa, 0x6b, 0x7c, 0x8d, 0xd4, 0xa1, 0xbe, 0x68, 0x0e, 0x9b, 0xa4, 0x2e,
+	0xe0, 0x89, 0x53, 0xb8, 0xad, 0x8e, 0xea, 0x7d, 0x38, 0x54, 0x47, 0x5c, 0xb9, 0x03, 0x6d, 0x42,
+	0x2a, 0x78, 0xea, 0x34, 0x07, 0x29, 0xb5, 0x49, 0xd6, 0x7b, 0xc9, 0x59, 0x1d, 0xb4, 0x7b, 0x17,
+	0xdc, 0x44, 0x2d, 0x14, 0xf6, 0x80, 0x9f, 0x08, 0x1c, 0x93, 0x06, 0x85, 0xb5, 0x64, 0xb3, 0xc2,
+	0xe6, 0xb0, 0x89, 0xb7, 0x0f, 0x8f, 0x0e, 0xcc, 0xbf, 0x1a, 0x9e, 0x17, 0x42, 0x06, 0xf8, 0x5f,
+	0x08, 0x8e, 0x31, 0x4e, 0xfb, 0xd0, 0x7e, 0x37, 0x48, 0x14, 0xb5, 0x9e, 0xab, 0x97, 0x4d, 0x81,
+	0xf2, 0x2f, 0x9b, 0x12, 0x68, 0x62, 0xe8, 0x85, 0x30, 0x10, 0xe9, 0x26, 0x63, 0x4a, 0x4b, 0x89,
+	0xf0, 0x2d, 0x84, 0x12, 0x58, 0xb3, 0x10, 0xae, 0xb1, 0x26, 0x18, 0x85, 0x49, 0x57, 0xbe, 0x7d,
+	0x82, 0x85, 0x77, 0xfb, 0x94, 0x41, 0x7f, 0xb0, 0xeb, 0xac, 0xe4, 0xfa, 0xfa, 0x3a, 0xa9, 0xd9,
+	0xa3, 0x0b, 0xb5, 0xc6, 0x6e, 0x95, 0x2e, 0x36, 0x24, 0xb3, 0xeb, 0x9b, 0xbd, 0xe4, 0xae, 0xf2,
+	0xdb, 0x54, 0x7d, 0xc8, 0x6b, 0xa4, 0x7f, 0xca, 0x6e, 0xc0, 0xae, 0x59, 0x56, 0xe1, 0xb6, 0xc5,
+	0x52, 0x9d, 0x87, 0xc2, 0xb2, 0x58, 0x6e, 0xcc, 0x9a, 0x60, 0x7f, 0xb5, 0x20, 0xb0, 0x8a, 0x1e,
+	0x91, 0x2c, 0xb9, 0x24, 0xdb, 0x84, 0x93, 0x34, 0x26, 0x69, 0x44, 0x89, 0x44, 0xdf, 0xd4, 0x79,
+	0xbb, 0xc5, 0x48, 0x9f, 0xe2, 0xdb, 0x8f, 0xb2, 0x93, 0x5c, 0xef, 0x2e, 0x4b, 0x0c, 0xf7, 0x48,
+	0x6d, 0x01, 0x4b, 0x6b, 0x64, 0xe5, 0x0e, 0xb4, 0xdb, 0x5d, 0x4e, 0x93, 0xdd, 0xf6, 0x0d, 0xef,
+	0xee, 0xba, 0xc1, 0xfa, 0x77, 0xd7, 0x2d, 0xb8, 0x89, 0x7a, 0x0c, 0x1d, 0xab, 0xb2, 0x97, 0x64,
+	0xb1, 0xce, 0xc5, 0xf0, 0x8e, 0x2c, 0x35, 0x45, 0x25, 0x47, 0xbf, 0x42, 0xdb, 0x0a, 0xcd, 0x0d,
+	0x99, 0xaf, 0x33, 0x75, 0x17, 0x64, 0xa1, 0x19, 0x28, 0xf9, 0xe6, 0xca, 0x87, 0xe5, 0x13, 0xaa,
+	0x4e, 0x07, 0x7d, 0x8d, 0xac, 0x5a, 0x13, 0xf7, 0x73, 0x25, 0x4a, 0xe8, 0xaa, 0xe0, 0x51, 0xfe,
+	0xbd, 0xa8, 0xff, 0xc8, 0x7c, 0x92, 0xf9, 0xea, 0xbf, 0x01, 0x00, 0x2c, 0xb5, 0xfb, 0x2e, 0x4b,
+	0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -708,17 +715,25 @@ type ArduinoCoreClient interface {
 	BoardDetails(ctx context.Context, in *BoardDetailsReq, opts ...grpc.CallOption) (*BoardDetailsResp, error)
 	BoardAttach(ctx context.Context, in *BoardAttachReq, opts ...grpc.CallOption) (ArduinoCore_BoardAttachClient, error)
 	BoardList(ctx context.Context, in *BoardListReq, opts ...grpc.CallOption) (*BoardListResp, error)
+	// Start watching for boards being connected or disconnected
+	BoardListWatch(ctx context.Context, in *BoardListWatchReq, opts ...grpc.CallOption) (ArduinoCore_BoardListWatchClient, error)
 	BoardListAll(ctx context.Context, in *BoardListAllReq, opts ...grpc.CallOption) (*BoardListAllResp, error)
 	Compile(ctx context.Context, in *CompileReq, opts ...grpc.CallOption) (ArduinoCore_CompileClient, error)
+	// Compute the code completions at a given position of a sketch
+	CodeComplete(ctx context.Context, in *CodeCompleteReq, opts ...grpc.CallOption) (*CodeCompleteResp, error)
 	PlatformInstall(ctx context.Context, in *PlatformInstallReq, opts ...grpc.CallOption) (ArduinoCore_PlatformInstallClient, error)
 	PlatformDownload(ctx context.Context, in *PlatformDownloadReq, opts ...grpc.CallOption) (ArduinoCore_PlatformDownloadClient, error)
 	PlatformUninstall(ctx context.Context, in *PlatformUninstallReq, opts ...grpc.CallOption) (ArduinoCore_PlatformUninstallClient, error)
 	PlatformUpgrade(ctx context.Context, in *PlatformUpgradeReq, opts ...grpc.CallOption) (ArduinoCore_PlatformUpgradeClient, error)
 	Upload(ctx context.Context, in *UploadReq, opts ...grpc.CallOption) (ArduinoCore_UploadClient, error)
+	// Upload the same binary on several boards in parallel
+	MultiUpload(ctx context.Context, in *MultiUploadReq, opts ...grpc.CallOption) (ArduinoCore_MultiUploadClient, error)
+	BurnBootloader(ctx context.Context, in *BurnBootloaderReq, opts ...grpc.CallOption) (ArduinoCore_BurnBootloaderClient, error)
 	PlatformSearch(ctx context.Context, in *PlatformSearchReq, opts ...grpc.CallOption) (*PlatformSearchResp, error)
 	PlatformList(ctx context.Context, in *PlatformListReq, opts ...grpc.CallOption) (*PlatformListResp, error)
 	LibraryDownload(ctx context.Context, in *LibraryDownloadReq, opts ...grpc.CallOption) (ArduinoCore_LibraryDownloadClient, error)
 	LibraryInstall(ctx context.Context, in *LibraryInstallReq, opts ...grpc.CallOption) (ArduinoCore_LibraryInstallClient, error)
+	LibraryResolveDependencies(ctx context.Context, in *LibraryResolveDependenciesReq, opts ...grpc.CallOption) (*LibraryResolveDependenciesResp, error)
 	LibraryUninstall(ctx context.Context, in *LibraryUninstallReq, opts ...grpc.CallOption) (ArduinoCore_LibraryUninstallClient, error)
 	LibraryUpgradeAll(ctx context.Context, in *LibraryUpgradeAllReq, opts ...grpc.CallOption) (ArduinoCore_LibraryUpgradeAllClient, error)
 	LibrarySearch(ctx context.Context, in *LibrarySearchReq, opts ...grpc.CallOption) (*LibrarySearchResp, error)
@@ -906,6 +921,38 @@ func (c *arduinoCoreClient) BoardList(ctx context.Context, in *BoardListReq, opt
 	return out, nil
 }
 
+func (c *arduinoCoreClient) BoardListWatch(ctx context.Context, in *BoardListWatchReq, opts ...grpc.CallOption) (ArduinoCore_BoardListWatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[4], "/cc.arduino.cli.commands.ArduinoCore/BoardListWatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreBoardListWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_BoardListWatchClient interface {
+	Recv() (*BoardListWatchResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreBoardListWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreBoardListWatchClient) Recv() (*BoardListWatchResp, error) {
+	m := new(BoardListWatchResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *arduinoCoreClient) BoardListAll(ctx context.Context, in *BoardListAllReq, opts ...grpc.CallOption) (*BoardListAllResp, error) {
 	out := new(BoardListAllResp)
 	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/BoardListAll", in, out, opts...)
@@ -916,7 +963,7 @@ func (c *arduinoCoreClient) BoardListAll(ctx context.Context, in *BoardListAllRe
 }
 
 func (c *arduinoCoreClient) Compile(ctx context.Context, in *CompileReq, opts ...grpc.CallOption) (ArduinoCore_CompileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[4], "/cc.arduino.cli.commands.ArduinoCore/Compile", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[5], "/cc.arduino.cli.commands.ArduinoCore/Compile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -947,8 +994,17 @@ func (x *arduinoCoreCompileClient) Recv() (*CompileResp, error) {
 	return m, nil
 }
 
+func (c *arduinoCoreClient) CodeComplete(ctx context.Context, in *CodeCompleteReq, opts ...grpc.CallOption) (*CodeCompleteResp, error) {
+	out := new(CodeCompleteResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/CodeComplete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreClient) PlatformInstall(ctx context.Context, in *PlatformInstallReq, opts ...grpc.CallOption) (ArduinoCore_PlatformInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[5], "/cc.arduino.cli.commands.ArduinoCore/PlatformInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[6], "/cc.arduino.cli.commands.ArduinoCore/PlatformInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -980,7 +1036,7 @@ func (x *arduinoCorePlatformInstallClient) Recv() (*PlatformInstallResp, error)
 }
 
 func (c *arduinoCoreClient) PlatformDownload(ctx context.Context, in *PlatformDownloadReq, opts ...grpc.CallOption) (ArduinoCore_PlatformDownloadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[6], "/cc.arduino.cli.commands.ArduinoCore/PlatformDownload", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[7], "/cc.arduino.cli.commands.ArduinoCore/PlatformDownload", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1012,7 +1068,7 @@ func (x *arduinoCorePlatformDownloadClient) Recv() (*PlatformDownloadResp, error
 }
 
 func (c *arduinoCoreClient) PlatformUninstall(ctx context.Context, in *PlatformUninstallReq, opts ...grpc.CallOption) (ArduinoCore_PlatformUninstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[7], "/cc.arduino.cli.commands.ArduinoCore/PlatformUninstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[8], "/cc.arduino.cli.commands.ArduinoCore/PlatformUninstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1044,7 +1100,7 @@ func (x *arduinoCorePlatformUninstallClient) Recv() (*PlatformUninstallResp, err
 }
 
 func (c *arduinoCoreClient) PlatformUpgrade(ctx context.Context, in *PlatformUpgradeReq, opts ...grpc.CallOption) (ArduinoCore_PlatformUpgradeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[8], "/cc.arduino.cli.commands.ArduinoCore/PlatformUpgrade", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[9], "/cc.arduino.cli.commands.ArduinoCore/PlatformUpgrade", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1076,7 +1132,7 @@ func (x *arduinoCorePlatformUpgradeClient) Recv() (*PlatformUpgradeResp, error)
 }
 
 func (c *arduinoCoreClient) Upload(ctx context.Context, in *UploadReq, opts ...grpc.CallOption) (ArduinoCore_UploadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[9], "/cc.arduino.cli.commands.ArduinoCore/Upload", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[10], "/cc.arduino.cli.commands.ArduinoCore/Upload", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1107,6 +1163,70 @@ func (x *arduinoCoreUploadClient) Recv() (*UploadResp, error) {
 	return m, nil
 }
 
+func (c *arduinoCoreClient) MultiUpload(ctx context.Context, in *MultiUploadReq, opts ...grpc.CallOption) (ArduinoCore_MultiUploadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[11], "/cc.arduino.cli.commands.ArduinoCore/MultiUpload", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreMultiUploadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_MultiUploadClient interface {
+	Recv() (*MultiUploadResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreMultiUploadClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreMultiUploadClient) Recv() (*MultiUploadResp, error) {
+	m := new(MultiUploadResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreClient) BurnBootloader(ctx context.Context, in *BurnBootloaderReq, opts ...grpc.CallOption) (ArduinoCore_BurnBootloaderClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[12], "/cc.arduino.cli.commands.ArduinoCore/BurnBootloader", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreBurnBootloaderClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_BurnBootloaderClient interface {
+	Recv() (*BurnBootloaderResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreBurnBootloaderClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreBurnBootloaderClient) Recv() (*BurnBootloaderResp, error) {
+	m := new(BurnBootloaderResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *arduinoCoreClient) PlatformSearch(ctx context.Context, in *PlatformSearchReq, opts ...grpc.CallOption) (*PlatformSearchResp, error) {
 	out := new(PlatformSearchResp)
 	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/PlatformSearch", in, out, opts...)
@@ -1126,7 +1246,7 @@ func (c *arduinoCoreClient) PlatformList(ctx context.Context, in *PlatformListRe
 }
 
 func (c *arduinoCoreClient) LibraryDownload(ctx context.Context, in *LibraryDownloadReq, opts ...grpc.CallOption) (ArduinoCore_LibraryDownloadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[10], "/cc.arduino.cli.commands.ArduinoCore/LibraryDownload", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[13], "/cc.arduino.cli.commands.ArduinoCore/LibraryDownload", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1158,7 +1278,7 @@ func (x *arduinoCoreLibraryDownloadClient) Recv() (*LibraryDownloadResp, error)
 }
 
 func (c *arduinoCoreClient) LibraryInstall(ctx context.Context, in *LibraryInstallReq, opts ...grpc.CallOption) (ArduinoCore_LibraryInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[11], "/cc.arduino.cli.commands.ArduinoCore/LibraryInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[14], "/cc.arduino.cli.commands.ArduinoCore/LibraryInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1189,8 +1309,17 @@ func (x *arduinoCoreLibraryInstallClient) Recv() (*LibraryInstallResp, error) {
 	return m, nil
 }
 
+func (c *arduinoCoreClient) LibraryResolveDependencies(ctx context.Context, in *LibraryResolveDependenciesReq, opts ...grpc.CallOption) (*LibraryResolveDependenciesResp, error) {
+	out := new(LibraryResolveDependenciesResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/LibraryResolveDependencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreClient) LibraryUninstall(ctx context.Context, in *LibraryUninstallReq, opts ...grpc.CallOption) (ArduinoCore_LibraryUninstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[12], "/cc.arduino.cli.commands.ArduinoCore/LibraryUninstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[15], "/cc.arduino.cli.commands.ArduinoCore/LibraryUninstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1222,7 +1351,7 @@ func (x *arduinoCoreLibraryUninstallClient) Recv() (*LibraryUninstallResp, error
 }
 
 func (c *arduinoCoreClient) LibraryUpgradeAll(ctx context.Context, in *LibraryUpgradeAllReq, opts ...grpc.CallOption) (ArduinoCore_LibraryUpgradeAllClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[13], "/cc.arduino.cli.commands.ArduinoCore/LibraryUpgradeAll", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[16], "/cc.arduino.cli.commands.ArduinoCore/LibraryUpgradeAll", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1288,17 +1417,25 @@ type ArduinoCoreServer interface {
 	BoardDetails(context.Context, *BoardDetailsReq) (*BoardDetailsResp, error)
 	BoardAttach(*BoardAttachReq, ArduinoCore_BoardAttachServer) error
 	BoardList(context.Context, *BoardListReq) (*BoardListResp, error)
+	// Start watching for boards being connected or disconnected
+	BoardListWatch(*BoardListWatchReq, ArduinoCore_BoardListWatchServer) error
 	BoardListAll(context.Context, *BoardListAllReq) (*BoardListAllResp, error)
 	Compile(*CompileReq, ArduinoCore_CompileServer) error
+	// Compute the code completions at a given position of a sketch
+	CodeComplete(context.Context, *CodeCompleteReq) (*CodeCompleteResp, error)
 	PlatformInstall(*PlatformInstallReq, ArduinoCore_PlatformInstallServer) error
 	PlatformDownload(*PlatformDownloadReq, ArduinoCore_PlatformDownloadServer) error
 	PlatformUninstall(*PlatformUninstallReq, ArduinoCore_PlatformUninstallServer) error
 	PlatformUpgrade(*PlatformUpgradeReq, ArduinoCore_PlatformUpgradeServer) error
 	Upload(*UploadReq, ArduinoCore_UploadServer) error
+	// Upload the same binary on several boards in parallel
+	MultiUpload(*MultiUploadReq, ArduinoCore_MultiUploadServer) error
+	BurnBootloader(*BurnBootloaderReq, ArduinoCore_BurnBootloaderServer) error
 	PlatformSearch(context.Context, *PlatformSearchReq) (*PlatformSearchResp, error)
 	PlatformList(context.Context, *PlatformListReq) (*PlatformListResp, error)
 	LibraryDownload(*LibraryDownloadReq, ArduinoCore_LibraryDownloadServer) error
 	LibraryInstall(*LibraryInstallReq, ArduinoCore_LibraryInstallServer) error
+	LibraryResolveDependencies(context.Context, *LibraryResolveDependenciesReq) (*LibraryResolveDependenciesResp, error)
 	LibraryUninstall(*LibraryUninstallReq, ArduinoCore_LibraryUninstallServer) error
 	LibraryUpgradeAll(*LibraryUpgradeAllReq, ArduinoCore_LibraryUpgradeAllServer) error
 	LibrarySearch(context.Context, *LibrarySearchReq) (*LibrarySearchResp, error)
@@ -1336,12 +1473,18 @@ func (*UnimplementedArduinoCoreServer) BoardAttach(req *BoardAttachReq, srv Ardu
 func (*UnimplementedArduinoCoreServer) BoardList(ctx context.Context, req *BoardListReq) (*BoardListResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BoardList not implemented")
 }
+func (*UnimplementedArduinoCoreServer) BoardListWatch(req *BoardListWatchReq, srv ArduinoCore_BoardListWatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method BoardListWatch not implemented")
+}
 func (*UnimplementedArduinoCoreServer) BoardListAll(ctx context.Context, req *BoardListAllReq) (*BoardListAllResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BoardListAll not implemented")
 }
 func (*UnimplementedArduinoCoreServer) Compile(req *CompileReq, srv ArduinoCore_CompileServer) error {
 	return status.Errorf(codes.Unimplemented, "method Compile not implemented")
 }
+func (*UnimplementedArduinoCoreServer) CodeComplete(ctx context.Context, req *CodeCompleteReq) (*CodeCompleteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeComplete not implemented")
+}
 func (*UnimplementedArduinoCoreServer) PlatformInstall(req *PlatformInstallReq, srv ArduinoCore_PlatformInstallServer) error {
 	return status.Errorf(codes.Unimplemented, "method PlatformInstall not implemented")
 }
@@ -1357,6 +1500,12 @@ func (*UnimplementedArduinoCoreServer) PlatformUpgrade(req *PlatformUpgradeReq,
 func (*UnimplementedArduinoCoreServer) Upload(req *UploadReq, srv ArduinoCore_UploadServer) error {
 	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
 }
+func (*UnimplementedArduinoCoreServer) MultiUpload(req *MultiUploadReq, srv ArduinoCore_MultiUploadServer) error {
+	return status.Errorf(codes.Unimplemented, "method MultiUpload not implemented")
+}
+func (*UnimplementedArduinoCoreServer) BurnBootloader(req *BurnBootloaderReq, srv ArduinoCore_BurnBootloaderServer) error {
+	return status.Errorf(codes.Unimplemented, "method BurnBootloader not implemented")
+}
 func (*UnimplementedArduinoCoreServer) PlatformSearch(ctx context.Context, req *PlatformSearchReq) (*PlatformSearchResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlatformSearch not implemented")
 }
@@ -1369,6 +1518,9 @@ func (*UnimplementedArduinoCoreServer) LibraryDownload(req *LibraryDownloadReq,
 func (*UnimplementedArduinoCoreServer) LibraryInstall(req *LibraryInstallReq, srv ArduinoCore_LibraryInstallServer) error {
 	return status.Errorf(codes.Unimplemented, "method LibraryInstall not implemented")
 }
+func (*UnimplementedArduinoCoreServer) LibraryResolveDependencies(ctx context.Context, req *LibraryResolveDependenciesReq) (*LibraryResolveDependenciesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LibraryResolveDependencies not implemented")
+}
 func (*UnimplementedArduinoCoreServer) LibraryUninstall(req *LibraryUninstallReq, srv ArduinoCore_LibraryUninstallServer) error {
 	return status.Errorf(codes.Unimplemented, "method LibraryUninstall not implemented")
 }
@@ -1560,6 +1712,27 @@ func _ArduinoCore_BoardList_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCore_BoardListWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BoardListWatchReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).BoardListWatch(m, &arduinoCoreBoardListWatchServer{stream})
+}
+
+type ArduinoCore_BoardListWatchServer interface {
+	Send(*BoardListWatchResp) error
+	grpc.ServerStream
+}
+
+type arduinoCoreBoardListWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreBoardListWatchServer) Send(m *BoardListWatchResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ArduinoCore_BoardListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BoardListAllReq)
 	if err := dec(in); err != nil {
@@ -1599,6 +1772,24 @@ func (x *arduinoCoreCompileServer) Send(m *CompileResp) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCore_CodeComplete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CodeCompleteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).CodeComplete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.ArduinoCore/CodeComplete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).CodeComplete(ctx, req.(*CodeCompleteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCore_PlatformInstall_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(PlatformInstallReq)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1704,6 +1895,48 @@ func (x *arduinoCoreUploadServer) Send(m *UploadResp) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCore_MultiUpload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MultiUploadReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).MultiUpload(m, &arduinoCoreMultiUploadServer{stream})
+}
+
+type ArduinoCore_MultiUploadServer interface {
+	Send(*MultiUploadResp) error
+	grpc.ServerStream
+}
+
+type arduinoCoreMultiUploadServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreMultiUploadServer) Send(m *MultiUploadResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCore_BurnBootloader_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BurnBootloaderReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).BurnBootloader(m, &arduinoCoreBurnBootloaderServer{stream})
+}
+
+type ArduinoCore_BurnBootloaderServer interface {
+	Send(*BurnBootloaderResp) error
+	grpc.ServerStream
+}
+
+type arduinoCoreBurnBootloaderServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreBurnBootloaderServer) Send(m *BurnBootloaderResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ArduinoCore_PlatformSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PlatformSearchReq)
 	if err := dec(in); err != nil {
@@ -1782,6 +2015,24 @@ func (x *arduinoCoreLibraryInstallServer) Send(m *LibraryInstallResp) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCore_LibraryResolveDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryResolveDependenciesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).LibraryResolveDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.ArduinoCore/LibraryResolveDependencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).LibraryResolveDependencies(ctx, req.(*LibraryResolveDependenciesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCore_LibraryUninstall_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(LibraryUninstallReq)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1888,6 +2139,10 @@ var _ArduinoCore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BoardListAll",
 			Handler:    _ArduinoCore_BoardListAll_Handler,
 		},
+		{
+			MethodName: "CodeComplete",
+			Handler:    _ArduinoCore_CodeComplete_Handler,
+		},
 		{
 			MethodName: "PlatformSearch",
 			Handler:    _ArduinoCore_PlatformSearch_Handler,
@@ -1896,6 +2151,10 @@ var _ArduinoCore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PlatformList",
 			Handler:    _ArduinoCore_PlatformList_Handler,
 		},
+		{
+			MethodName: "LibraryResolveDependencies",
+			Handler:    _ArduinoCore_LibraryResolveDependencies_Handler,
+		},
 		{
 			MethodName: "LibrarySearch",
 			Handler:    _ArduinoCore_LibrarySearch_Handler,
@@ -1926,6 +2185,11 @@ var _ArduinoCore_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCore_BoardAttach_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "BoardListWatch",
+			Handler:       _ArduinoCore_BoardListWatch_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Compile",
 			Handler:       _ArduinoCore_Compile_Handler,
@@ -1956,6 +2220,16 @@ var _ArduinoCore_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCore_Upload_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "MultiUpload",
+			Handler:       _ArduinoCore_MultiUpload_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BurnBootloader",
+			Handler:       _ArduinoCore_BurnBootloader_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "LibraryDownload",
 			Handler:       _ArduinoCore_LibraryDownload_Handler,
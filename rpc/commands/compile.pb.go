@@ -21,23 +21,30 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type CompileReq struct {
-	Instance             *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
-	Fqbn                 string    `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
-	SketchPath           string    `protobuf:"bytes,3,opt,name=sketchPath,proto3" json:"sketchPath,omitempty"`
-	ShowProperties       bool      `protobuf:"varint,4,opt,name=showProperties,proto3" json:"showProperties,omitempty"`
-	Preprocess           bool      `protobuf:"varint,5,opt,name=preprocess,proto3" json:"preprocess,omitempty"`
-	BuildCachePath       string    `protobuf:"bytes,6,opt,name=buildCachePath,proto3" json:"buildCachePath,omitempty"`
-	BuildPath            string    `protobuf:"bytes,7,opt,name=buildPath,proto3" json:"buildPath,omitempty"`
-	BuildProperties      []string  `protobuf:"bytes,8,rep,name=buildProperties,proto3" json:"buildProperties,omitempty"`
-	Warnings             string    `protobuf:"bytes,9,opt,name=warnings,proto3" json:"warnings,omitempty"`
-	Verbose              bool      `protobuf:"varint,10,opt,name=verbose,proto3" json:"verbose,omitempty"`
-	Quiet                bool      `protobuf:"varint,11,opt,name=quiet,proto3" json:"quiet,omitempty"`
-	VidPid               string    `protobuf:"bytes,12,opt,name=vidPid,proto3" json:"vidPid,omitempty"`
-	ExportFile           string    `protobuf:"bytes,13,opt,name=exportFile,proto3" json:"exportFile,omitempty"`
-	Jobs                 int32     `protobuf:"varint,14,opt,name=jobs,proto3" json:"jobs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Instance                      *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	Fqbn                          string    `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	SketchPath                    string    `protobuf:"bytes,3,opt,name=sketchPath,proto3" json:"sketchPath,omitempty"`
+	ShowProperties                bool      `protobuf:"varint,4,opt,name=showProperties,proto3" json:"showProperties,omitempty"`
+	Preprocess                    bool      `protobuf:"varint,5,opt,name=preprocess,proto3" json:"preprocess,omitempty"`
+	BuildCachePath                string    `protobuf:"bytes,6,opt,name=buildCachePath,proto3" json:"buildCachePath,omitempty"`
+	BuildPath                     string    `protobuf:"bytes,7,opt,name=buildPath,proto3" json:"buildPath,omitempty"`
+	BuildProperties               []string  `protobuf:"bytes,8,rep,name=buildProperties,proto3" json:"buildProperties,omitempty"`
+	Warnings                      string    `protobuf:"bytes,9,opt,name=warnings,proto3" json:"warnings,omitempty"`
+	Verbose                       bool      `protobuf:"varint,10,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	Quiet                         bool      `protobuf:"varint,11,opt,name=quiet,proto3" json:"quiet,omitempty"`
+	VidPid                        string    `protobuf:"bytes,12,opt,name=vidPid,proto3" json:"vidPid,omitempty"`
+	ExportFile                    string    `protobuf:"bytes,13,opt,name=exportFile,proto3" json:"exportFile,omitempty"`
+	Jobs                          int32     `protobuf:"varint,14,opt,name=jobs,proto3" json:"jobs,omitempty"`
+	Upload                        bool      `protobuf:"varint,15,opt,name=upload,proto3" json:"upload,omitempty"`
+	Port                          string    `protobuf:"bytes,16,opt,name=port,proto3" json:"port,omitempty"`
+	Verify                        bool      `protobuf:"varint,17,opt,name=verify,proto3" json:"verify,omitempty"`
+	Programmer                    string    `protobuf:"bytes,18,opt,name=programmer,proto3" json:"programmer,omitempty"`
+	CreateCompilationDatabaseOnly bool      `protobuf:"varint,19,opt,name=create_compilation_database_only,json=createCompilationDatabaseOnly,proto3" json:"create_compilation_database_only,omitempty"`
+	Fqbns                         []string  `protobuf:"bytes,20,rep,name=fqbns,proto3" json:"fqbns,omitempty"`
+	Profile                       string    `protobuf:"bytes,21,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral          struct{}  `json:"-"`
+	XXX_unrecognized              []byte    `json:"-"`
+	XXX_sizecache                 int32     `json:"-"`
 }
 
 func (m *CompileReq) Reset()         { *m = CompileReq{} }
@@ -163,12 +170,69 @@ func (m *CompileReq) GetJobs() int32 {
 	return 0
 }
 
+func (m *CompileReq) GetUpload() bool {
+	if m != nil {
+		return m.Upload
+	}
+	return false
+}
+
+func (m *CompileReq) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *CompileReq) GetVerify() bool {
+	if m != nil {
+		return m.Verify
+	}
+	return false
+}
+
+func (m *CompileReq) GetProgrammer() string {
+	if m != nil {
+		return m.Programmer
+	}
+	return ""
+}
+
+func (m *CompileReq) GetCreateCompilationDatabaseOnly() bool {
+	if m != nil {
+		return m.CreateCompilationDatabaseOnly
+	}
+	return false
+}
+
+func (m *CompileReq) GetFqbns() []string {
+	if m != nil {
+		return m.Fqbns
+	}
+	return nil
+}
+
+func (m *CompileReq) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
 type CompileResp struct {
-	OutStream            []byte   `protobuf:"bytes,1,opt,name=out_stream,json=outStream,proto3" json:"out_stream,omitempty"`
-	ErrStream            []byte   `protobuf:"bytes,2,opt,name=err_stream,json=errStream,proto3" json:"err_stream,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	OutStream []byte `protobuf:"bytes,1,opt,name=out_stream,json=outStream,proto3" json:"out_stream,omitempty"`
+	ErrStream []byte `protobuf:"bytes,2,opt,name=err_stream,json=errStream,proto3" json:"err_stream,omitempty"`
+	// Progress of the compilation and of the upload, if requested.
+	TaskProgress *TaskProgress `protobuf:"bytes,3,opt,name=task_progress,json=taskProgress,proto3" json:"task_progress,omitempty"`
+	// The result of the compilation, sent in the last message.
+	Result *CompileResult `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	// The diagnostics emitted by the compiler, sent when the build terminates.
+	Diagnostics []*CompileDiagnostic `protobuf:"bytes,5,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// The results of a compilation for several boards, sent in the last message.
+	BoardResults         []*BoardCompileResult `protobuf:"bytes,6,rep,name=board_results,json=boardResults,proto3" json:"board_results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *CompileResp) Reset()         { *m = CompileResp{} }
@@ -210,38 +274,868 @@ func (m *CompileResp) GetErrStream() []byte {
 	return nil
 }
 
+func (m *CompileResp) GetTaskProgress() *TaskProgress {
+	if m != nil {
+		return m.TaskProgress
+	}
+	return nil
+}
+
+func (m *CompileResp) GetResult() *CompileResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *CompileResp) GetDiagnostics() []*CompileDiagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+func (m *CompileResp) GetBoardResults() []*BoardCompileResult {
+	if m != nil {
+		return m.BoardResults
+	}
+	return nil
+}
+
+type BoardCompileResult struct {
+	Fqbn                 string         `protobuf:"bytes,1,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	Success              bool           `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Error                string         `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Result               *CompileResult `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	Warnings             int32          `protobuf:"varint,5,opt,name=warnings,proto3" json:"warnings,omitempty"`
+	Errors               int32          `protobuf:"varint,6,opt,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BoardCompileResult) Reset()         { *m = BoardCompileResult{} }
+func (m *BoardCompileResult) String() string { return proto.CompactTextString(m) }
+func (*BoardCompileResult) ProtoMessage()    {}
+func (*BoardCompileResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86bc582849c76c3d, []int{2}
+}
+
+func (m *BoardCompileResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BoardCompileResult.Unmarshal(m, b)
+}
+func (m *BoardCompileResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BoardCompileResult.Marshal(b, m, deterministic)
+}
+func (m *BoardCompileResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoardCompileResult.Merge(m, src)
+}
+func (m *BoardCompileResult) XXX_Size() int {
+	return xxx_messageInfo_BoardCompileResult.Size(m)
+}
+func (m *BoardCompileResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoardCompileResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoardCompileResult proto.InternalMessageInfo
+
+func (m *BoardCompileResult) GetFqbn() string {
+	if m != nil {
+		return m.Fqbn
+	}
+	return ""
+}
+
+func (m *BoardCompileResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *BoardCompileResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *BoardCompileResult) GetResult() *CompileResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *BoardCompileResult) GetWarnings() int32 {
+	if m != nil {
+		return m.Warnings
+	}
+	return 0
+}
+
+func (m *BoardCompileResult) GetErrors() int32 {
+	if m != nil {
+		return m.Errors
+	}
+	return 0
+}
+
+type CompileDiagnostic struct {
+	File                 string               `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Line                 int32                `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	Column               int32                `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
+	Severity             string               `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	Message              string               `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Notes                []*CompileDiagnostic `protobuf:"bytes,6,rep,name=notes,proto3" json:"notes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CompileDiagnostic) Reset()         { *m = CompileDiagnostic{} }
+func (m *CompileDiagnostic) String() string { return proto.CompactTextString(m) }
+func (*CompileDiagnostic) ProtoMessage()    {}
+func (*CompileDiagnostic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86bc582849c76c3d, []int{3}
+}
+
+func (m *CompileDiagnostic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompileDiagnostic.Unmarshal(m, b)
+}
+func (m *CompileDiagnostic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompileDiagnostic.Marshal(b, m, deterministic)
+}
+func (m *CompileDiagnostic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompileDiagnostic.Merge(m, src)
+}
+func (m *CompileDiagnostic) XXX_Size() int {
+	return xxx_messageInfo_CompileDiagnostic.Size(m)
+}
+func (m *CompileDiagnostic) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompileDiagnostic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompileDiagnostic proto.InternalMessageInfo
+
+func (m *CompileDiagnostic) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
+func (m *CompileDiagnostic) GetLine() int32 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *CompileDiagnostic) GetColumn() int32 {
+	if m != nil {
+		return m.Column
+	}
+	return 0
+}
+
+func (m *CompileDiagnostic) GetSeverity() string {
+	if m != nil {
+		return m.Severity
+	}
+	return ""
+}
+
+func (m *CompileDiagnostic) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *CompileDiagnostic) GetNotes() []*CompileDiagnostic {
+	if m != nil {
+		return m.Notes
+	}
+	return nil
+}
+
+type CompileResult struct {
+	BuildPath            string                      `protobuf:"bytes,1,opt,name=build_path,json=buildPath,proto3" json:"build_path,omitempty"`
+	Artifacts            []string                    `protobuf:"bytes,2,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	BuildProperties      map[string]string           `protobuf:"bytes,3,rep,name=build_properties,json=buildProperties,proto3" json:"build_properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	UsedLibraries        []*UsedLibrary              `protobuf:"bytes,4,rep,name=used_libraries,json=usedLibraries,proto3" json:"used_libraries,omitempty"`
+	BoardPlatform        *InstalledPlatformReference `protobuf:"bytes,5,opt,name=board_platform,json=boardPlatform,proto3" json:"board_platform,omitempty"`
+	BuildPlatform        *InstalledPlatformReference `protobuf:"bytes,6,opt,name=build_platform,json=buildPlatform,proto3" json:"build_platform,omitempty"`
+	Tools                []*InstalledToolReference   `protobuf:"bytes,7,rep,name=tools,proto3" json:"tools,omitempty"`
+	ExecutableSize       *ExecutableSize             `protobuf:"bytes,8,opt,name=executable_size,json=executableSize,proto3" json:"executable_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *CompileResult) Reset()         { *m = CompileResult{} }
+func (m *CompileResult) String() string { return proto.CompactTextString(m) }
+func (*CompileResult) ProtoMessage()    {}
+func (*CompileResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86bc582849c76c3d, []int{4}
+}
+
+func (m *CompileResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompileResult.Unmarshal(m, b)
+}
+func (m *CompileResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompileResult.Marshal(b, m, deterministic)
+}
+func (m *CompileResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompileResult.Merge(m, src)
+}
+func (m *CompileResult) XXX_Size() int {
+	return xxx_messageInfo_CompileResult.Size(m)
+}
+func (m *CompileResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompileResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompileResult proto.InternalMessageInfo
+
+func (m *CompileResult) GetBuildPath() string {
+	if m != nil {
+		return m.BuildPath
+	}
+	return ""
+}
+
+func (m *CompileResult) GetArtifacts() []string {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
+func (m *CompileResult) GetBuildProperties() map[string]string {
+	if m != nil {
+		return m.BuildProperties
+	}
+	return nil
+}
+
+func (m *CompileResult) GetUsedLibraries() []*UsedLibrary {
+	if m != nil {
+		return m.UsedLibraries
+	}
+	return nil
+}
+
+func (m *CompileResult) GetBoardPlatform() *InstalledPlatformReference {
+	if m != nil {
+		return m.BoardPlatform
+	}
+	return nil
+}
+
+func (m *CompileResult) GetBuildPlatform() *InstalledPlatformReference {
+	if m != nil {
+		return m.BuildPlatform
+	}
+	return nil
+}
+
+func (m *CompileResult) GetTools() []*InstalledToolReference {
+	if m != nil {
+		return m.Tools
+	}
+	return nil
+}
+
+func (m *CompileResult) GetExecutableSize() *ExecutableSize {
+	if m != nil {
+		return m.ExecutableSize
+	}
+	return nil
+}
+
+type UsedLibrary struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Location             string   `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	InstallDir           string   `protobuf:"bytes,4,opt,name=install_dir,json=installDir,proto3" json:"install_dir,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UsedLibrary) Reset()         { *m = UsedLibrary{} }
+func (m *UsedLibrary) String() string { return proto.CompactTextString(m) }
+func (*UsedLibrary) ProtoMessage()    {}
+func (*UsedLibrary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86bc582849c76c3d, []int{5}
+}
+
+func (m *UsedLibrary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UsedLibrary.Unmarshal(m, b)
+}
+func (m *UsedLibrary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UsedLibrary.Marshal(b, m, deterministic)
+}
+func (m *UsedLibrary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsedLibrary.Merge(m, src)
+}
+func (m *UsedLibrary) XXX_Size() int {
+	return xxx_messageInfo_UsedLibrary.Size(m)
+}
+func (m *UsedLibrary) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsedLibrary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsedLibrary proto.InternalMessageInfo
+
+func (m *UsedLibrary) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UsedLibrary) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *UsedLibrary) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
+}
+
+func (m *UsedLibrary) GetInstallDir() string {
+	if m != nil {
+		return m.InstallDir
+	}
+	return ""
+}
+
+type InstalledPlatformReference struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	InstallDir           string   `protobuf:"bytes,3,opt,name=install_dir,json=installDir,proto3" json:"install_dir,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InstalledPlatformReference) Reset()         { *m = InstalledPlatformReference{} }
+func (m *InstalledPlatformReference) String() string { return proto.CompactTextString(m) }
+func (*InstalledPlatformReference) ProtoMessage()    {}
+func (*InstalledPlatformReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86bc582849c76c3d, []int{6}
+}
+
+func (m *InstalledPlatformReference) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InstalledPlatformReference.Unmarshal(m, b)
+}
+func (m *InstalledPlatformReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InstalledPlatformReference.Marshal(b, m, deterministic)
+}
+func (m *InstalledPlatformReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstalledPlatformReference.Merge(m, src)
+}
+func (m *InstalledPlatformReference) XXX_Size() int {
+	return xxx_messageInfo_InstalledPlatformReference.Size(m)
+}
+func (m *InstalledPlatformReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstalledPlatformReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstalledPlatformReference proto.InternalMessageInfo
+
+func (m *InstalledPlatformReference) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *InstalledPlatformReference) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *InstalledPlatformReference) GetInstallDir() string {
+	if m != nil {
+		return m.InstallDir
+	}
+	return ""
+}
+
+type InstalledToolReference struct {
+	Packager             string   `protobuf:"bytes,1,opt,name=packager,proto3" json:"packager,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version              string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	InstallDir           string   `protobuf:"bytes,4,opt,name=install_dir,json=installDir,proto3" json:"install_dir,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InstalledToolReference) Reset()         { *m = InstalledToolReference{} }
+func (m *InstalledToolReference) String() string { return proto.CompactTextString(m) }
+func (*InstalledToolReference) ProtoMessage()    {}
+func (*InstalledToolReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86bc582849c76c3d, []int{7}
+}
+
+func (m *InstalledToolReference) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InstalledToolReference.Unmarshal(m, b)
+}
+func (m *InstalledToolReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InstalledToolReference.Marshal(b, m, deterministic)
+}
+func (m *InstalledToolReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstalledToolReference.Merge(m, src)
+}
+func (m *InstalledToolReference) XXX_Size() int {
+	return xxx_messageInfo_InstalledToolReference.Size(m)
+}
+func (m *InstalledToolReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstalledToolReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstalledToolReference proto.InternalMessageInfo
+
+func (m *InstalledToolReference) GetPackager() string {
+	if m != nil {
+		return m.Packager
+	}
+	return ""
+}
+
+func (m *InstalledToolReference) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InstalledToolReference) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *InstalledToolReference) GetInstallDir() string {
+	if m != nil {
+		return m.InstallDir
+	}
+	return ""
+}
+
+type ExecutableSize struct {
+	TextSize             int64    `protobuf:"varint,1,opt,name=text_size,json=textSize,proto3" json:"text_size,omitempty"`
+	MaxTextSize          int64    `protobuf:"varint,2,opt,name=max_text_size,json=maxTextSize,proto3" json:"max_text_size,omitempty"`
+	DataSize             int64    `protobuf:"varint,3,opt,name=data_size,json=dataSize,proto3" json:"data_size,omitempty"`
+	MaxDataSize          int64    `protobuf:"varint,4,opt,name=max_data_size,json=maxDataSize,proto3" json:"max_data_size,omitempty"`
+	EepromSize           int64    `protobuf:"varint,5,opt,name=eeprom_size,json=eepromSize,proto3" json:"eeprom_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecutableSize) Reset()         { *m = ExecutableSize{} }
+func (m *ExecutableSize) String() string { return proto.CompactTextString(m) }
+func (*ExecutableSize) ProtoMessage()    {}
+func (*ExecutableSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86bc582849c76c3d, []int{8}
+}
+
+func (m *ExecutableSize) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecutableSize.Unmarshal(m, b)
+}
+func (m *ExecutableSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecutableSize.Marshal(b, m, deterministic)
+}
+func (m *ExecutableSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutableSize.Merge(m, src)
+}
+func (m *ExecutableSize) XXX_Size() int {
+	return xxx_messageInfo_ExecutableSize.Size(m)
+}
+func (m *ExecutableSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutableSize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutableSize proto.InternalMessageInfo
+
+func (m *ExecutableSize) GetTextSize() int64 {
+	if m != nil {
+		return m.TextSize
+	}
+	return 0
+}
+
+func (m *ExecutableSize) GetMaxTextSize() int64 {
+	if m != nil {
+		return m.MaxTextSize
+	}
+	return 0
+}
+
+func (m *ExecutableSize) GetDataSize() int64 {
+	if m != nil {
+		return m.DataSize
+	}
+	return 0
+}
+
+func (m *ExecutableSize) GetMaxDataSize() int64 {
+	if m != nil {
+		return m.MaxDataSize
+	}
+	return 0
+}
+
+func (m *ExecutableSize) GetEepromSize() int64 {
+	if m != nil {
+		return m.EepromSize
+	}
+	return 0
+}
+
+type CodeCompleteReq struct {
+	Instance             *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	Fqbn                 string    `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	SketchPath           string    `protobuf:"bytes,3,opt,name=sketchPath,proto3" json:"sketchPath,omitempty"`
+	File                 string    `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
+	Line                 int32     `protobuf:"varint,5,opt,name=line,proto3" json:"line,omitempty"`
+	Column               int32     `protobuf:"varint,6,opt,name=column,proto3" json:"column,omitempty"`
+	UnsavedContent       string    `protobuf:"bytes,7,opt,name=unsaved_content,json=unsavedContent,proto3" json:"unsaved_content,omitempty"`
+	BuildPath            string    `protobuf:"bytes,8,opt,name=buildPath,proto3" json:"buildPath,omitempty"`
+	BuildProperties      []string  `protobuf:"bytes,9,rep,name=buildProperties,proto3" json:"buildProperties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CodeCompleteReq) Reset()         { *m = CodeCompleteReq{} }
+func (m *CodeCompleteReq) String() string { return proto.CompactTextString(m) }
+func (*CodeCompleteReq) ProtoMessage()    {}
+func (*CodeCompleteReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86bc582849c76c3d, []int{9}
+}
+
+func (m *CodeCompleteReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CodeCompleteReq.Unmarshal(m, b)
+}
+func (m *CodeCompleteReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CodeCompleteReq.Marshal(b, m, deterministic)
+}
+func (m *CodeCompleteReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeCompleteReq.Merge(m, src)
+}
+func (m *CodeCompleteReq) XXX_Size() int {
+	return xxx_messageInfo_CodeCompleteReq.Size(m)
+}
+func (m *CodeCompleteReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeCompleteReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeCompleteReq proto.InternalMessageInfo
+
+func (m *CodeCompleteReq) GetInstance() *Instance {
+	if m != nil {
+		return m.Instance
+	}
+	return nil
+}
+
+func (m *CodeCompleteReq) GetFqbn() string {
+	if m != nil {
+		return m.Fqbn
+	}
+	return ""
+}
+
+func (m *CodeCompleteReq) GetSketchPath() string {
+	if m != nil {
+		return m.SketchPath
+	}
+	return ""
+}
+
+func (m *CodeCompleteReq) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
+func (m *CodeCompleteReq) GetLine() int32 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *CodeCompleteReq) GetColumn() int32 {
+	if m != nil {
+		return m.Column
+	}
+	return 0
+}
+
+func (m *CodeCompleteReq) GetUnsavedContent() string {
+	if m != nil {
+		return m.UnsavedContent
+	}
+	return ""
+}
+
+func (m *CodeCompleteReq) GetBuildPath() string {
+	if m != nil {
+		return m.BuildPath
+	}
+	return ""
+}
+
+func (m *CodeCompleteReq) GetBuildProperties() []string {
+	if m != nil {
+		return m.BuildProperties
+	}
+	return nil
+}
+
+type CodeCompleteResp struct {
+	Items                []*CompletionItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CodeCompleteResp) Reset()         { *m = CodeCompleteResp{} }
+func (m *CodeCompleteResp) String() string { return proto.CompactTextString(m) }
+func (*CodeCompleteResp) ProtoMessage()    {}
+func (*CodeCompleteResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86bc582849c76c3d, []int{10}
+}
+
+func (m *CodeCompleteResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CodeCompleteResp.Unmarshal(m, b)
+}
+func (m *CodeCompleteResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CodeCompleteResp.Marshal(b, m, deterministic)
+}
+func (m *CodeCompleteResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeCompleteResp.Merge(m, src)
+}
+func (m *CodeCompleteResp) XXX_Size() int {
+	return xxx_messageInfo_CodeCompleteResp.Size(m)
+}
+func (m *CodeCompleteResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeCompleteResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeCompleteResp proto.InternalMessageInfo
+
+func (m *CodeCompleteResp) GetItems() []*CompletionItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type CompletionItem struct {
+	Label                string   `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Detail               string   `protobuf:"bytes,2,opt,name=detail,proto3" json:"detail,omitempty"`
+	ResultType           string   `protobuf:"bytes,3,opt,name=result_type,json=resultType,proto3" json:"result_type,omitempty"`
+	Parameters           []string `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	Documentation        string   `protobuf:"bytes,5,opt,name=documentation,proto3" json:"documentation,omitempty"`
+	Priority             int32    `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	Availability         string   `protobuf:"bytes,7,opt,name=availability,proto3" json:"availability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompletionItem) Reset()         { *m = CompletionItem{} }
+func (m *CompletionItem) String() string { return proto.CompactTextString(m) }
+func (*CompletionItem) ProtoMessage()    {}
+func (*CompletionItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86bc582849c76c3d, []int{11}
+}
+
+func (m *CompletionItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompletionItem.Unmarshal(m, b)
+}
+func (m *CompletionItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompletionItem.Marshal(b, m, deterministic)
+}
+func (m *CompletionItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompletionItem.Merge(m, src)
+}
+func (m *CompletionItem) XXX_Size() int {
+	return xxx_messageInfo_CompletionItem.Size(m)
+}
+func (m *CompletionItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompletionItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompletionItem proto.InternalMessageInfo
+
+func (m *CompletionItem) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *CompletionItem) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+func (m *CompletionItem) GetResultType() string {
+	if m != nil {
+		return m.ResultType
+	}
+	return ""
+}
+
+func (m *CompletionItem) GetParameters() []string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+func (m *CompletionItem) GetDocumentation() string {
+	if m != nil {
+		return m.Documentation
+	}
+	return ""
+}
+
+func (m *CompletionItem) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *CompletionItem) GetAvailability() string {
+	if m != nil {
+		return m.Availability
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CompileReq)(nil), "cc.arduino.cli.commands.CompileReq")
 	proto.RegisterType((*CompileResp)(nil), "cc.arduino.cli.commands.CompileResp")
+	proto.RegisterType((*BoardCompileResult)(nil), "cc.arduino.cli.commands.BoardCompileResult")
+	proto.RegisterType((*CompileDiagnostic)(nil), "cc.arduino.cli.commands.CompileDiagnostic")
+	proto.RegisterType((*CompileResult)(nil), "cc.arduino.cli.commands.CompileResult")
+	proto.RegisterMapType((map[string]string)(nil), "cc.arduino.cli.commands.CompileResult.BuildPropertiesEntry")
+	proto.RegisterType((*UsedLibrary)(nil), "cc.arduino.cli.commands.UsedLibrary")
+	proto.RegisterType((*InstalledPlatformReference)(nil), "cc.arduino.cli.commands.InstalledPlatformReference")
+	proto.RegisterType((*InstalledToolReference)(nil), "cc.arduino.cli.commands.InstalledToolReference")
+	proto.RegisterType((*ExecutableSize)(nil), "cc.arduino.cli.commands.ExecutableSize")
+	proto.RegisterType((*CodeCompleteReq)(nil), "cc.arduino.cli.commands.CodeCompleteReq")
+	proto.RegisterType((*CodeCompleteResp)(nil), "cc.arduino.cli.commands.CodeCompleteResp")
+	proto.RegisterType((*CompletionItem)(nil), "cc.arduino.cli.commands.CompletionItem")
 }
 
 func init() { proto.RegisterFile("commands/compile.proto", fileDescriptor_86bc582849c76c3d) }
 
 var fileDescriptor_86bc582849c76c3d = []byte{
-	// 392 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x8f, 0xd3, 0x30,
-	0x10, 0x85, 0x95, 0xed, 0xb6, 0x9b, 0x4c, 0x97, 0x45, 0xb2, 0x60, 0xb1, 0x2a, 0x40, 0xa1, 0x07,
-	0x14, 0x09, 0x35, 0x91, 0xe0, 0xcc, 0x85, 0x4a, 0x48, 0x88, 0x4b, 0x15, 0x6e, 0x5c, 0x50, 0xe2,
-	0x0c, 0x8d, 0x21, 0xb1, 0x5d, 0xdb, 0x69, 0xf9, 0x97, 0xfc, 0x25, 0x94, 0x49, 0xd3, 0x56, 0x95,
-	0x38, 0xc5, 0xf3, 0xbd, 0xe7, 0x67, 0xc7, 0x33, 0xf0, 0x28, 0x74, 0xdb, 0x16, 0xaa, 0x72, 0x99,
-	0xd0, 0xad, 0x91, 0x0d, 0xa6, 0xc6, 0x6a, 0xaf, 0xd9, 0x0b, 0x21, 0xd2, 0xc2, 0x56, 0x9d, 0x54,
-	0x3a, 0x15, 0x8d, 0x4c, 0x47, 0xdb, 0xe2, 0xf9, 0xe5, 0x86, 0x56, 0xab, 0xc1, 0xbf, 0xfc, 0x3b,
-	0x01, 0x58, 0x0f, 0x09, 0x39, 0xee, 0xd8, 0x47, 0x08, 0xa5, 0x72, 0xbe, 0x50, 0x02, 0x79, 0x10,
-	0x07, 0xc9, 0xfc, 0xfd, 0x9b, 0xf4, 0x3f, 0x89, 0xe9, 0x97, 0xa3, 0x31, 0x3f, 0x6d, 0x61, 0x0c,
-	0x6e, 0x7f, 0xee, 0x4a, 0xc5, 0x6f, 0xe2, 0x20, 0x89, 0x72, 0x5a, 0xb3, 0xd7, 0x00, 0xee, 0x37,
-	0x7a, 0x51, 0x6f, 0x0a, 0x5f, 0xf3, 0x09, 0x29, 0x17, 0x84, 0xbd, 0x85, 0x07, 0x57, 0xeb, 0xc3,
-	0xc6, 0x6a, 0x83, 0xd6, 0x4b, 0x74, 0xfc, 0x36, 0x0e, 0x92, 0x30, 0xbf, 0xa2, 0x7d, 0x8e, 0xb1,
-	0x68, 0xac, 0x16, 0xe8, 0x1c, 0x9f, 0x92, 0xe7, 0x82, 0xf4, 0x39, 0x65, 0x27, 0x9b, 0x6a, 0x5d,
-	0x88, 0x1a, 0xe9, 0xac, 0x19, 0x9d, 0x75, 0x45, 0xd9, 0x4b, 0x88, 0x88, 0x90, 0xe5, 0x8e, 0x2c,
-	0x67, 0xc0, 0x12, 0x78, 0x3a, 0x14, 0xe7, 0xeb, 0x84, 0xf1, 0x24, 0x89, 0xf2, 0x6b, 0xcc, 0x16,
-	0x10, 0x1e, 0x0a, 0xab, 0xa4, 0xda, 0x3a, 0x1e, 0x51, 0xcc, 0xa9, 0x66, 0x1c, 0xee, 0xf6, 0x68,
-	0x4b, 0xed, 0x90, 0x03, 0x5d, 0x74, 0x2c, 0xd9, 0x33, 0x98, 0xee, 0x3a, 0x89, 0x9e, 0xcf, 0x89,
-	0x0f, 0x05, 0x7b, 0x84, 0xd9, 0x5e, 0x56, 0x1b, 0x59, 0xf1, 0x7b, 0x4a, 0x3a, 0x56, 0xfd, 0x3f,
-	0xe3, 0x1f, 0xa3, 0xad, 0xff, 0x2c, 0x1b, 0xe4, 0x4f, 0x86, 0xb7, 0x3b, 0x93, 0xfe, 0xbd, 0x7f,
-	0xe9, 0xd2, 0xf1, 0x87, 0x38, 0x48, 0xa6, 0x39, 0xad, 0x97, 0x5f, 0x61, 0x7e, 0x6a, 0xa8, 0x33,
-	0xec, 0x15, 0x80, 0xee, 0xfc, 0x0f, 0xe7, 0x2d, 0x16, 0x2d, 0xf5, 0xf4, 0x3e, 0x8f, 0x74, 0xe7,
-	0xbf, 0x11, 0xe8, 0x65, 0xb4, 0x76, 0x94, 0x6f, 0x06, 0x19, 0xad, 0x1d, 0xe4, 0x4f, 0xab, 0xef,
-	0xef, 0xb6, 0xd2, 0xd7, 0x5d, 0xd9, 0xb7, 0x3d, 0x3b, 0x8e, 0xc1, 0xf8, 0x5d, 0x89, 0x46, 0x66,
-	0xd6, 0x88, 0x6c, 0x1c, 0x89, 0x72, 0x46, 0x43, 0xf5, 0xe1, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x14, 0x81, 0xb3, 0x02, 0x9e, 0x02, 0x00, 0x00,
+	// 1311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x16, 0x06, 0x25, 0x53, 0x96, 0x8e, 0x2c, 0xd9, 0x99, 0x9b, 0xe4, 0x12, 0x4e, 0x72, 0xa3, 0x2b,
+	0xe4, 0x26, 0xc2, 0x0d, 0x22, 0x03, 0xce, 0xa6, 0x68, 0x91, 0xa2, 0xb0, 0x9d, 0x16, 0x69, 0x03,
+	0x54, 0x65, 0xdc, 0x4d, 0x36, 0xc2, 0x88, 0x3c, 0x92, 0xa7, 0x26, 0x39, 0xcc, 0xcc, 0xd0, 0xb1,
+	0xb2, 0xed, 0x3b, 0xf4, 0x29, 0xba, 0x2b, 0xfa, 0x14, 0xed, 0x6b, 0xf4, 0x19, 0xba, 0x2d, 0xe6,
+	0x87, 0x94, 0x64, 0x5b, 0x4e, 0x8a, 0x2c, 0xba, 0x12, 0xbf, 0x33, 0xe7, 0x7c, 0x67, 0xe6, 0xfc,
+	0xcd, 0x08, 0x6e, 0x47, 0x3c, 0x4d, 0x69, 0x16, 0xcb, 0xbd, 0x88, 0xa7, 0x39, 0x4b, 0x70, 0x98,
+	0x0b, 0xae, 0x38, 0xf9, 0x77, 0x14, 0x0d, 0xa9, 0x88, 0x0b, 0x96, 0xf1, 0x61, 0x94, 0xb0, 0x61,
+	0xa9, 0xb6, 0x7b, 0x6b, 0xd9, 0x20, 0xe5, 0x99, 0xd5, 0xef, 0xff, 0xec, 0x03, 0x1c, 0x5a, 0x86,
+	0x10, 0xdf, 0x90, 0x67, 0xd0, 0x64, 0x99, 0x54, 0x34, 0x8b, 0x30, 0xf0, 0x7a, 0xde, 0xa0, 0xbd,
+	0xff, 0xdf, 0xe1, 0x1a, 0xc6, 0xe1, 0x0b, 0xa7, 0x18, 0x56, 0x26, 0x84, 0xc0, 0xc6, 0xf4, 0xcd,
+	0x24, 0x0b, 0x6a, 0x3d, 0x6f, 0xd0, 0x0a, 0xcd, 0x37, 0xf9, 0x0f, 0x80, 0x3c, 0x45, 0x15, 0x9d,
+	0x8c, 0xa8, 0x3a, 0x09, 0xea, 0x66, 0x65, 0x49, 0x42, 0x1e, 0x42, 0x57, 0x9e, 0xf0, 0xb7, 0x23,
+	0xc1, 0x73, 0x14, 0x8a, 0xa1, 0x0c, 0x36, 0x7a, 0xde, 0xa0, 0x19, 0x5e, 0x90, 0x6a, 0x9e, 0x5c,
+	0x60, 0x2e, 0x78, 0x84, 0x52, 0x06, 0xbe, 0xd1, 0x59, 0x92, 0x68, 0x9e, 0x49, 0xc1, 0x92, 0xf8,
+	0x90, 0x46, 0x27, 0x68, 0x7c, 0x35, 0x8c, 0xaf, 0x0b, 0x52, 0x72, 0x17, 0x5a, 0x46, 0x62, 0x54,
+	0x36, 0x8d, 0xca, 0x42, 0x40, 0x06, 0xb0, 0x6d, 0xc1, 0x62, 0x3b, 0xcd, 0x5e, 0x7d, 0xd0, 0x0a,
+	0x2f, 0x8a, 0xc9, 0x2e, 0x34, 0xdf, 0x52, 0x91, 0xb1, 0x6c, 0x26, 0x83, 0x96, 0xa1, 0xa9, 0x30,
+	0x09, 0x60, 0xf3, 0x0c, 0xc5, 0x84, 0x4b, 0x0c, 0xc0, 0x6c, 0xb4, 0x84, 0xe4, 0x26, 0xf8, 0x6f,
+	0x0a, 0x86, 0x2a, 0x68, 0x1b, 0xb9, 0x05, 0xe4, 0x36, 0x34, 0xce, 0x58, 0x3c, 0x62, 0x71, 0xb0,
+	0x65, 0x98, 0x1c, 0xd2, 0x67, 0xc6, 0xf3, 0x9c, 0x0b, 0xf5, 0x25, 0x4b, 0x30, 0xe8, 0xd8, 0xd8,
+	0x2d, 0x24, 0x3a, 0xde, 0x3f, 0xf0, 0x89, 0x0c, 0xba, 0x3d, 0x6f, 0xe0, 0x87, 0xe6, 0x5b, 0x73,
+	0x15, 0x79, 0xc2, 0x69, 0x1c, 0x6c, 0x1b, 0x17, 0x0e, 0x69, 0x5d, 0x6d, 0x17, 0xec, 0xd8, 0xdc,
+	0xe8, 0x6f, 0xe3, 0x17, 0x05, 0x9b, 0xce, 0x83, 0x1b, 0x56, 0xd7, 0x22, 0x1b, 0x6b, 0x3e, 0x13,
+	0x34, 0x4d, 0x51, 0x04, 0xc4, 0xfa, 0x5d, 0x48, 0xc8, 0x57, 0xd0, 0x8b, 0x04, 0x52, 0x85, 0x63,
+	0x5b, 0x7d, 0x54, 0x31, 0x9e, 0x8d, 0x63, 0xaa, 0xe8, 0x84, 0x4a, 0x1c, 0xf3, 0x2c, 0x99, 0x07,
+	0xff, 0x32, 0x8c, 0xf7, 0xac, 0xde, 0xe1, 0x42, 0xed, 0xc8, 0x69, 0x7d, 0x9b, 0x25, 0x73, 0x1d,
+	0x0e, 0x5d, 0x24, 0x32, 0xb8, 0x69, 0x82, 0x6c, 0x81, 0x0e, 0x5f, 0x2e, 0xf8, 0x54, 0x9f, 0xf9,
+	0x96, 0xf1, 0x5d, 0xc2, 0xfe, 0x9f, 0x35, 0x68, 0x57, 0xe5, 0x2a, 0x73, 0x72, 0x0f, 0x80, 0x17,
+	0x6a, 0x2c, 0x95, 0x40, 0x9a, 0x9a, 0x8a, 0xdd, 0x0a, 0x5b, 0xbc, 0x50, 0xaf, 0x8c, 0x40, 0x2f,
+	0xa3, 0x10, 0xe5, 0x72, 0xcd, 0x2e, 0xa3, 0x10, 0x6e, 0xf9, 0x6b, 0xe8, 0x28, 0x2a, 0x4f, 0xc7,
+	0xe6, 0x64, 0xba, 0xaa, 0xea, 0xa6, 0xe4, 0xff, 0xb7, 0xb6, 0xe4, 0x8f, 0xa9, 0x3c, 0x1d, 0x39,
+	0xe5, 0x70, 0x4b, 0x2d, 0x21, 0xf2, 0x39, 0x34, 0x04, 0xca, 0x22, 0x51, 0xa6, 0x7c, 0xdb, 0xfb,
+	0x0f, 0xd7, 0x92, 0x2c, 0xf6, 0x5f, 0x24, 0x2a, 0x74, 0x56, 0xe4, 0x25, 0xb4, 0x63, 0x46, 0x67,
+	0x19, 0x97, 0x8a, 0x45, 0xba, 0xbe, 0xeb, 0x83, 0xf6, 0xfe, 0xff, 0xdf, 0x47, 0x72, 0x54, 0x99,
+	0x84, 0xcb, 0xe6, 0x64, 0x04, 0x9d, 0x09, 0xa7, 0x22, 0x1e, 0x5b, 0x76, 0x19, 0x34, 0x0c, 0xdf,
+	0xe3, 0xb5, 0x7c, 0x07, 0x5a, 0x7b, 0x75, 0x67, 0x5b, 0x86, 0xc1, 0x02, 0xd9, 0xff, 0xdd, 0x03,
+	0x72, 0x59, 0xa9, 0xea, 0x78, 0x6f, 0xa9, 0xe3, 0x03, 0xd8, 0x94, 0x45, 0x64, 0xda, 0xb4, 0x66,
+	0xab, 0xdf, 0x41, 0x9d, 0x6e, 0x14, 0x82, 0x0b, 0x37, 0x06, 0x2c, 0xf8, 0xe8, 0xd0, 0x2d, 0x77,
+	0xa2, 0x6f, 0x3a, 0xa1, 0xc2, 0xba, 0xc2, 0x8d, 0x13, 0x69, 0xa6, 0x81, 0x1f, 0x3a, 0xd4, 0xff,
+	0xcd, 0x83, 0x1b, 0x97, 0x62, 0x68, 0x4e, 0xa3, 0xab, 0xae, 0x3c, 0x8d, 0xeb, 0xb1, 0x84, 0x65,
+	0x68, 0x8e, 0xe2, 0x87, 0xe6, 0x5b, 0xb3, 0x46, 0x3c, 0x29, 0xd2, 0xcc, 0x1c, 0xc4, 0x0f, 0x1d,
+	0xd2, 0x3b, 0x91, 0xa8, 0x7b, 0x48, 0xcd, 0xcd, 0x59, 0x5a, 0x61, 0x85, 0x75, 0x54, 0x52, 0x94,
+	0x92, 0xce, 0xd0, 0x6c, 0xb2, 0x15, 0x96, 0x90, 0x7c, 0x01, 0x7e, 0xc6, 0x15, 0x96, 0x49, 0xfa,
+	0x3b, 0x49, 0xb7, 0x86, 0xfd, 0x9f, 0x7c, 0xe8, 0xac, 0xe6, 0xe5, 0x1e, 0x80, 0x19, 0x58, 0xe3,
+	0x5c, 0x8f, 0x39, 0xef, 0xe2, 0x98, 0xbb, 0x0b, 0x2d, 0x2a, 0x14, 0x9b, 0xd2, 0x48, 0xe9, 0x24,
+	0xe9, 0xde, 0x5b, 0x08, 0xc8, 0x14, 0x76, 0x9c, 0xf1, 0x62, 0x0a, 0xd6, 0xcd, 0xde, 0x3e, 0xfb,
+	0xb0, 0xd4, 0x0c, 0x0f, 0x56, 0x87, 0xe5, 0xf3, 0x4c, 0x89, 0xf9, 0xe5, 0x11, 0xfa, 0x0d, 0x74,
+	0x0b, 0x89, 0xf1, 0x38, 0x61, 0x13, 0x41, 0x85, 0x1d, 0xfd, 0xda, 0xcb, 0x83, 0xb5, 0x5e, 0xbe,
+	0x97, 0x18, 0xbf, 0x34, 0xda, 0xf3, 0xb0, 0x53, 0x54, 0x40, 0x93, 0xbd, 0x86, 0xae, 0x2d, 0xf9,
+	0x3c, 0xa1, 0x6a, 0xca, 0x45, 0x6a, 0xc2, 0xdc, 0xde, 0x7f, 0x7a, 0xfd, 0x05, 0x96, 0x24, 0x18,
+	0x8f, 0x9c, 0x45, 0x88, 0x53, 0x14, 0xa8, 0xaf, 0x34, 0xdb, 0x3d, 0xa5, 0xdc, 0x70, 0xdb, 0x80,
+	0x94, 0xdc, 0x8d, 0x8f, 0xe1, 0x36, 0x61, 0x28, 0xb9, 0x9f, 0x83, 0xaf, 0x38, 0x4f, 0x64, 0xb0,
+	0x69, 0xce, 0xbe, 0xf7, 0x7e, 0xca, 0x63, 0xce, 0x93, 0x05, 0x9d, 0xb5, 0x26, 0x23, 0xd8, 0xc6,
+	0x73, 0x8c, 0x0a, 0x45, 0x27, 0x09, 0x8e, 0x25, 0x7b, 0x87, 0x41, 0xd3, 0xec, 0xf1, 0xd1, 0x5a,
+	0xc2, 0xe7, 0x95, 0xfe, 0x2b, 0xf6, 0x0e, 0xc3, 0x2e, 0xae, 0xe0, 0xdd, 0x03, 0xb8, 0x79, 0x55,
+	0x1a, 0xc9, 0x0e, 0xd4, 0x4f, 0x71, 0xee, 0x6a, 0x4a, 0x7f, 0xea, 0xb6, 0x3e, 0xa3, 0x49, 0x81,
+	0xee, 0xde, 0xb7, 0xe0, 0xd3, 0xda, 0x27, 0x5e, 0xff, 0x1c, 0xda, 0x4b, 0x29, 0xd3, 0xbd, 0x94,
+	0xd1, 0xb4, 0xea, 0x2f, 0xfd, 0xed, 0xee, 0x4a, 0xc9, 0x78, 0xf9, 0x6c, 0x28, 0xa1, 0xee, 0xa6,
+	0x84, 0x47, 0xe6, 0xd2, 0x70, 0x03, 0xa3, 0xc2, 0xe4, 0x3e, 0xb4, 0x99, 0x8d, 0xc7, 0x38, 0x66,
+	0xc2, 0x35, 0x1b, 0x38, 0xd1, 0x11, 0x13, 0xfd, 0x19, 0xec, 0xae, 0xcf, 0x01, 0xe9, 0x42, 0x8d,
+	0xc5, 0x6e, 0x1b, 0x35, 0x16, 0x5f, 0xb3, 0x89, 0x0b, 0x8e, 0xea, 0x97, 0x1c, 0xfd, 0xe8, 0xc1,
+	0xed, 0xab, 0x53, 0xa3, 0x0f, 0x90, 0xd3, 0xe8, 0x94, 0xce, 0x50, 0x38, 0x5f, 0x15, 0xae, 0x42,
+	0x51, 0xbb, 0x3a, 0x14, 0xf5, 0x6b, 0x77, 0x71, 0xf9, 0xb8, 0xbf, 0x78, 0xd0, 0x5d, 0xcd, 0x27,
+	0xb9, 0x03, 0x2d, 0x85, 0xe7, 0xca, 0xd6, 0x82, 0x76, 0x5f, 0x0f, 0x9b, 0x5a, 0x60, 0x16, 0xfb,
+	0xd0, 0x49, 0xe9, 0xf9, 0x78, 0xa1, 0x50, 0x33, 0x0a, 0xed, 0x94, 0x9e, 0x1f, 0x97, 0x3a, 0x77,
+	0xa0, 0xa5, 0xaf, 0x74, 0xbb, 0x5e, 0xb7, 0x04, 0x5a, 0xb0, 0x4c, 0xb0, 0x50, 0xd8, 0xa8, 0x08,
+	0x8e, 0x4a, 0x9d, 0xfb, 0xd0, 0x46, 0xfd, 0x3e, 0x4b, 0xad, 0x86, 0x6f, 0x34, 0xc0, 0x8a, 0xb4,
+	0x42, 0xff, 0xd7, 0x1a, 0x6c, 0x1f, 0xf2, 0xd8, 0x3c, 0x0f, 0x12, 0x54, 0xff, 0xd4, 0x13, 0xb4,
+	0x1c, 0xfb, 0x1b, 0x57, 0x8c, 0x7d, 0xff, 0xca, 0xb1, 0xdf, 0x58, 0x19, 0xfb, 0x8f, 0x60, 0xbb,
+	0xc8, 0x24, 0x3d, 0xc3, 0x78, 0x1c, 0xf1, 0x4c, 0x61, 0xa6, 0xdc, 0xc3, 0xb2, 0xeb, 0xc4, 0x87,
+	0x56, 0xba, 0xfa, 0xf6, 0x6c, 0x7e, 0xc0, 0xdb, 0xb3, 0x75, 0xe5, 0xdb, 0xb3, 0xff, 0x1d, 0xec,
+	0xac, 0x86, 0x4d, 0xe6, 0xe4, 0x19, 0xf8, 0x4c, 0x61, 0x2a, 0x03, 0xaf, 0x57, 0xbf, 0xb6, 0xed,
+	0x9d, 0x15, 0xe3, 0xd9, 0x0b, 0x85, 0x69, 0x68, 0xad, 0xfa, 0x7f, 0x78, 0xd0, 0x5d, 0x5d, 0xd1,
+	0x6d, 0x9d, 0xd0, 0x09, 0x26, 0xae, 0x76, 0x2d, 0xd0, 0x41, 0x88, 0x51, 0x51, 0x96, 0xb8, 0x10,
+	0x3b, 0xa4, 0x93, 0x6d, 0xef, 0xe3, 0xb1, 0x9a, 0xe7, 0x58, 0x46, 0xd9, 0x8a, 0x8e, 0xe7, 0x39,
+	0x9a, 0x47, 0x25, 0x15, 0x34, 0x45, 0x85, 0xc2, 0x4e, 0xfa, 0x56, 0xb8, 0x24, 0x21, 0x0f, 0xa0,
+	0x13, 0xf3, 0xa8, 0x48, 0x31, 0x53, 0xb6, 0xe7, 0xed, 0x35, 0xb9, 0x2a, 0x34, 0x3d, 0x25, 0x18,
+	0x37, 0x57, 0xac, 0xcd, 0x42, 0x85, 0x49, 0x1f, 0xb6, 0xe8, 0x19, 0x65, 0x09, 0x9d, 0xb0, 0x44,
+	0xaf, 0xdb, 0x24, 0xac, 0xc8, 0x0e, 0x9e, 0xbc, 0x7e, 0x3c, 0x63, 0xea, 0xa4, 0x98, 0xe8, 0x80,
+	0xec, 0xb9, 0x00, 0x95, 0xbf, 0x4f, 0xa2, 0x84, 0xed, 0x89, 0x3c, 0xda, 0x2b, 0x83, 0x35, 0x69,
+	0x98, 0xbf, 0x49, 0x4f, 0xff, 0x1a, 0x00, 0x80, 0x6c, 0x5f, 0x15, 0x70, 0x0d, 0x00, 0x00,
 }
@@ -174,6 +174,7 @@ type LibraryInstallReq struct {
 	Instance             *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
 	Name                 string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Version              string    `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	NoDeps               bool      `protobuf:"varint,4,opt,name=no_deps,json=noDeps,proto3" json:"no_deps,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
@@ -225,6 +226,13 @@ func (m *LibraryInstallReq) GetVersion() string {
 	return ""
 }
 
+func (m *LibraryInstallReq) GetNoDeps() bool {
+	if m != nil {
+		return m.NoDeps
+	}
+	return false
+}
+
 type LibraryInstallResp struct {
 	Progress             *DownloadProgress `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
 	TaskProgress         *TaskProgress     `protobuf:"bytes,2,opt,name=task_progress,json=taskProgress,proto3" json:"task_progress,omitempty"`
@@ -272,6 +280,155 @@ func (m *LibraryInstallResp) GetTaskProgress() *TaskProgress {
 	return nil
 }
 
+type LibraryResolveDependenciesReq struct {
+	Instance             *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	Name                 string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version              string    `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *LibraryResolveDependenciesReq) Reset()         { *m = LibraryResolveDependenciesReq{} }
+func (m *LibraryResolveDependenciesReq) String() string { return proto.CompactTextString(m) }
+func (*LibraryResolveDependenciesReq) ProtoMessage()    {}
+func (*LibraryResolveDependenciesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9feed0d29806df6c, []int{4}
+}
+
+func (m *LibraryResolveDependenciesReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LibraryResolveDependenciesReq.Unmarshal(m, b)
+}
+func (m *LibraryResolveDependenciesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LibraryResolveDependenciesReq.Marshal(b, m, deterministic)
+}
+func (m *LibraryResolveDependenciesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LibraryResolveDependenciesReq.Merge(m, src)
+}
+func (m *LibraryResolveDependenciesReq) XXX_Size() int {
+	return xxx_messageInfo_LibraryResolveDependenciesReq.Size(m)
+}
+func (m *LibraryResolveDependenciesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LibraryResolveDependenciesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LibraryResolveDependenciesReq proto.InternalMessageInfo
+
+func (m *LibraryResolveDependenciesReq) GetInstance() *Instance {
+	if m != nil {
+		return m.Instance
+	}
+	return nil
+}
+
+func (m *LibraryResolveDependenciesReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LibraryResolveDependenciesReq) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+type LibraryResolveDependenciesResp struct {
+	Dependencies         []*LibraryDependencyStatus `protobuf:"bytes,1,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *LibraryResolveDependenciesResp) Reset()         { *m = LibraryResolveDependenciesResp{} }
+func (m *LibraryResolveDependenciesResp) String() string { return proto.CompactTextString(m) }
+func (*LibraryResolveDependenciesResp) ProtoMessage()    {}
+func (*LibraryResolveDependenciesResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9feed0d29806df6c, []int{5}
+}
+
+func (m *LibraryResolveDependenciesResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LibraryResolveDependenciesResp.Unmarshal(m, b)
+}
+func (m *LibraryResolveDependenciesResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LibraryResolveDependenciesResp.Marshal(b, m, deterministic)
+}
+func (m *LibraryResolveDependenciesResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LibraryResolveDependenciesResp.Merge(m, src)
+}
+func (m *LibraryResolveDependenciesResp) XXX_Size() int {
+	return xxx_messageInfo_LibraryResolveDependenciesResp.Size(m)
+}
+func (m *LibraryResolveDependenciesResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LibraryResolveDependenciesResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LibraryResolveDependenciesResp proto.InternalMessageInfo
+
+func (m *LibraryResolveDependenciesResp) GetDependencies() []*LibraryDependencyStatus {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+type LibraryDependencyStatus struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	VersionRequired      string   `protobuf:"bytes,2,opt,name=version_required,json=versionRequired,proto3" json:"version_required,omitempty"`
+	VersionInstalled     string   `protobuf:"bytes,3,opt,name=version_installed,json=versionInstalled,proto3" json:"version_installed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LibraryDependencyStatus) Reset()         { *m = LibraryDependencyStatus{} }
+func (m *LibraryDependencyStatus) String() string { return proto.CompactTextString(m) }
+func (*LibraryDependencyStatus) ProtoMessage()    {}
+func (*LibraryDependencyStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9feed0d29806df6c, []int{6}
+}
+
+func (m *LibraryDependencyStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LibraryDependencyStatus.Unmarshal(m, b)
+}
+func (m *LibraryDependencyStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LibraryDependencyStatus.Marshal(b, m, deterministic)
+}
+func (m *LibraryDependencyStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LibraryDependencyStatus.Merge(m, src)
+}
+func (m *LibraryDependencyStatus) XXX_Size() int {
+	return xxx_messageInfo_LibraryDependencyStatus.Size(m)
+}
+func (m *LibraryDependencyStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_LibraryDependencyStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LibraryDependencyStatus proto.InternalMessageInfo
+
+func (m *LibraryDependencyStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LibraryDependencyStatus) GetVersionRequired() string {
+	if m != nil {
+		return m.VersionRequired
+	}
+	return ""
+}
+
+func (m *LibraryDependencyStatus) GetVersionInstalled() string {
+	if m != nil {
+		return m.VersionInstalled
+	}
+	return ""
+}
+
 type LibraryUninstallReq struct {
 	Instance             *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
 	Name                 string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -285,7 +442,7 @@ func (m *LibraryUninstallReq) Reset()         { *m = LibraryUninstallReq{} }
 func (m *LibraryUninstallReq) String() string { return proto.CompactTextString(m) }
 func (*LibraryUninstallReq) ProtoMessage()    {}
 func (*LibraryUninstallReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9feed0d29806df6c, []int{4}
+	return fileDescriptor_9feed0d29806df6c, []int{7}
 }
 
 func (m *LibraryUninstallReq) XXX_Unmarshal(b []byte) error {
@@ -338,7 +495,7 @@ func (m *LibraryUninstallResp) Reset()         { *m = LibraryUninstallResp{} }
 func (m *LibraryUninstallResp) String() string { return proto.CompactTextString(m) }
 func (*LibraryUninstallResp) ProtoMessage()    {}
 func (*LibraryUninstallResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9feed0d29806df6c, []int{5}
+	return fileDescriptor_9feed0d29806df6c, []int{8}
 }
 
 func (m *LibraryUninstallResp) XXX_Unmarshal(b []byte) error {
@@ -377,7 +534,7 @@ func (m *LibraryUpgradeAllReq) Reset()         { *m = LibraryUpgradeAllReq{} }
 func (m *LibraryUpgradeAllReq) String() string { return proto.CompactTextString(m) }
 func (*LibraryUpgradeAllReq) ProtoMessage()    {}
 func (*LibraryUpgradeAllReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9feed0d29806df6c, []int{6}
+	return fileDescriptor_9feed0d29806df6c, []int{9}
 }
 
 func (m *LibraryUpgradeAllReq) XXX_Unmarshal(b []byte) error {
@@ -417,7 +574,7 @@ func (m *LibraryUpgradeAllResp) Reset()         { *m = LibraryUpgradeAllResp{} }
 func (m *LibraryUpgradeAllResp) String() string { return proto.CompactTextString(m) }
 func (*LibraryUpgradeAllResp) ProtoMessage()    {}
 func (*LibraryUpgradeAllResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9feed0d29806df6c, []int{7}
+	return fileDescriptor_9feed0d29806df6c, []int{10}
 }
 
 func (m *LibraryUpgradeAllResp) XXX_Unmarshal(b []byte) error {
@@ -464,7 +621,7 @@ func (m *LibrarySearchReq) Reset()         { *m = LibrarySearchReq{} }
 func (m *LibrarySearchReq) String() string { return proto.CompactTextString(m) }
 func (*LibrarySearchReq) ProtoMessage()    {}
 func (*LibrarySearchReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9feed0d29806df6c, []int{8}
+	return fileDescriptor_9feed0d29806df6c, []int{11}
 }
 
 func (m *LibrarySearchReq) XXX_Unmarshal(b []byte) error {
@@ -510,7 +667,7 @@ func (m *LibrarySearchResp) Reset()         { *m = LibrarySearchResp{} }
 func (m *LibrarySearchResp) String() string { return proto.CompactTextString(m) }
 func (*LibrarySearchResp) ProtoMessage()    {}
 func (*LibrarySearchResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9feed0d29806df6c, []int{9}
+	return fileDescriptor_9feed0d29806df6c, []int{12}
 }
 
 func (m *LibrarySearchResp) XXX_Unmarshal(b []byte) error {
@@ -551,7 +708,7 @@ func (m *SearchedLibrary) Reset()         { *m = SearchedLibrary{} }
 func (m *SearchedLibrary) String() string { return proto.CompactTextString(m) }
 func (*SearchedLibrary) ProtoMessage()    {}
 func (*SearchedLibrary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9feed0d29806df6c, []int{10}
+	return fileDescriptor_9feed0d29806df6c, []int{13}
 }
 
 func (m *SearchedLibrary) XXX_Unmarshal(b []byte) error {
@@ -594,26 +751,27 @@ func (m *SearchedLibrary) GetLatest() *LibraryRelease {
 }
 
 type LibraryRelease struct {
-	Author               string            `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
-	Version              string            `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Maintainer           string            `protobuf:"bytes,3,opt,name=maintainer,proto3" json:"maintainer,omitempty"`
-	Sentence             string            `protobuf:"bytes,4,opt,name=sentence,proto3" json:"sentence,omitempty"`
-	Paragraph            string            `protobuf:"bytes,5,opt,name=paragraph,proto3" json:"paragraph,omitempty"`
-	Website              string            `protobuf:"bytes,6,opt,name=website,proto3" json:"website,omitempty"`
-	Category             string            `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
-	Architectures        []string          `protobuf:"bytes,8,rep,name=architectures,proto3" json:"architectures,omitempty"`
-	Types                []string          `protobuf:"bytes,9,rep,name=types,proto3" json:"types,omitempty"`
-	Resources            *DownloadResource `protobuf:"bytes,10,opt,name=resources,proto3" json:"resources,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Author               string               `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	Version              string               `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Maintainer           string               `protobuf:"bytes,3,opt,name=maintainer,proto3" json:"maintainer,omitempty"`
+	Sentence             string               `protobuf:"bytes,4,opt,name=sentence,proto3" json:"sentence,omitempty"`
+	Paragraph            string               `protobuf:"bytes,5,opt,name=paragraph,proto3" json:"paragraph,omitempty"`
+	Website              string               `protobuf:"bytes,6,opt,name=website,proto3" json:"website,omitempty"`
+	Category             string               `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	Architectures        []string             `protobuf:"bytes,8,rep,name=architectures,proto3" json:"architectures,omitempty"`
+	Types                []string             `protobuf:"bytes,9,rep,name=types,proto3" json:"types,omitempty"`
+	Resources            *DownloadResource    `protobuf:"bytes,10,opt,name=resources,proto3" json:"resources,omitempty"`
+	Dependencies         []*LibraryDependency `protobuf:"bytes,11,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *LibraryRelease) Reset()         { *m = LibraryRelease{} }
 func (m *LibraryRelease) String() string { return proto.CompactTextString(m) }
 func (*LibraryRelease) ProtoMessage()    {}
 func (*LibraryRelease) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9feed0d29806df6c, []int{11}
+	return fileDescriptor_9feed0d29806df6c, []int{14}
 }
 
 func (m *LibraryRelease) XXX_Unmarshal(b []byte) error {
@@ -704,6 +862,60 @@ func (m *LibraryRelease) GetResources() *DownloadResource {
 	return nil
 }
 
+func (m *LibraryRelease) GetDependencies() []*LibraryDependency {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+type LibraryDependency struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	VersionConstraint    string   `protobuf:"bytes,2,opt,name=version_constraint,json=versionConstraint,proto3" json:"version_constraint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LibraryDependency) Reset()         { *m = LibraryDependency{} }
+func (m *LibraryDependency) String() string { return proto.CompactTextString(m) }
+func (*LibraryDependency) ProtoMessage()    {}
+func (*LibraryDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9feed0d29806df6c, []int{15}
+}
+
+func (m *LibraryDependency) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LibraryDependency.Unmarshal(m, b)
+}
+func (m *LibraryDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LibraryDependency.Marshal(b, m, deterministic)
+}
+func (m *LibraryDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LibraryDependency.Merge(m, src)
+}
+func (m *LibraryDependency) XXX_Size() int {
+	return xxx_messageInfo_LibraryDependency.Size(m)
+}
+func (m *LibraryDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_LibraryDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LibraryDependency proto.InternalMessageInfo
+
+func (m *LibraryDependency) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LibraryDependency) GetVersionConstraint() string {
+	if m != nil {
+		return m.VersionConstraint
+	}
+	return ""
+}
+
 type DownloadResource struct {
 	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 	Archivefilename      string   `protobuf:"bytes,2,opt,name=archivefilename,proto3" json:"archivefilename,omitempty"`
@@ -719,7 +931,7 @@ func (m *DownloadResource) Reset()         { *m = DownloadResource{} }
 func (m *DownloadResource) String() string { return proto.CompactTextString(m) }
 func (*DownloadResource) ProtoMessage()    {}
 func (*DownloadResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9feed0d29806df6c, []int{12}
+	return fileDescriptor_9feed0d29806df6c, []int{16}
 }
 
 func (m *DownloadResource) XXX_Unmarshal(b []byte) error {
@@ -788,7 +1000,7 @@ func (m *LibraryListReq) Reset()         { *m = LibraryListReq{} }
 func (m *LibraryListReq) String() string { return proto.CompactTextString(m) }
 func (*LibraryListReq) ProtoMessage()    {}
 func (*LibraryListReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9feed0d29806df6c, []int{13}
+	return fileDescriptor_9feed0d29806df6c, []int{17}
 }
 
 func (m *LibraryListReq) XXX_Unmarshal(b []byte) error {
@@ -841,7 +1053,7 @@ func (m *LibraryListResp) Reset()         { *m = LibraryListResp{} }
 func (m *LibraryListResp) String() string { return proto.CompactTextString(m) }
 func (*LibraryListResp) ProtoMessage()    {}
 func (*LibraryListResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9feed0d29806df6c, []int{14}
+	return fileDescriptor_9feed0d29806df6c, []int{18}
 }
 
 func (m *LibraryListResp) XXX_Unmarshal(b []byte) error {
@@ -881,7 +1093,7 @@ func (m *InstalledLibrary) Reset()         { *m = InstalledLibrary{} }
 func (m *InstalledLibrary) String() string { return proto.CompactTextString(m) }
 func (*InstalledLibrary) ProtoMessage()    {}
 func (*InstalledLibrary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9feed0d29806df6c, []int{15}
+	return fileDescriptor_9feed0d29806df6c, []int{19}
 }
 
 func (m *InstalledLibrary) XXX_Unmarshal(b []byte) error {
@@ -949,7 +1161,7 @@ func (m *Library) Reset()         { *m = Library{} }
 func (m *Library) String() string { return proto.CompactTextString(m) }
 func (*Library) ProtoMessage()    {}
 func (*Library) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9feed0d29806df6c, []int{16}
+	return fileDescriptor_9feed0d29806df6c, []int{20}
 }
 
 func (m *Library) XXX_Unmarshal(b []byte) error {
@@ -1138,6 +1350,9 @@ func init() {
 	proto.RegisterType((*LibraryDownloadResp)(nil), "cc.arduino.cli.commands.LibraryDownloadResp")
 	proto.RegisterType((*LibraryInstallReq)(nil), "cc.arduino.cli.commands.LibraryInstallReq")
 	proto.RegisterType((*LibraryInstallResp)(nil), "cc.arduino.cli.commands.LibraryInstallResp")
+	proto.RegisterType((*LibraryResolveDependenciesReq)(nil), "cc.arduino.cli.commands.LibraryResolveDependenciesReq")
+	proto.RegisterType((*LibraryResolveDependenciesResp)(nil), "cc.arduino.cli.commands.LibraryResolveDependenciesResp")
+	proto.RegisterType((*LibraryDependencyStatus)(nil), "cc.arduino.cli.commands.LibraryDependencyStatus")
 	proto.RegisterType((*LibraryUninstallReq)(nil), "cc.arduino.cli.commands.LibraryUninstallReq")
 	proto.RegisterType((*LibraryUninstallResp)(nil), "cc.arduino.cli.commands.LibraryUninstallResp")
 	proto.RegisterType((*LibraryUpgradeAllReq)(nil), "cc.arduino.cli.commands.LibraryUpgradeAllReq")
@@ -1147,6 +1362,7 @@ func init() {
 	proto.RegisterType((*SearchedLibrary)(nil), "cc.arduino.cli.commands.SearchedLibrary")
 	proto.RegisterMapType((map[string]*LibraryRelease)(nil), "cc.arduino.cli.commands.SearchedLibrary.ReleasesEntry")
 	proto.RegisterType((*LibraryRelease)(nil), "cc.arduino.cli.commands.LibraryRelease")
+	proto.RegisterType((*LibraryDependency)(nil), "cc.arduino.cli.commands.LibraryDependency")
 	proto.RegisterType((*DownloadResource)(nil), "cc.arduino.cli.commands.DownloadResource")
 	proto.RegisterType((*LibraryListReq)(nil), "cc.arduino.cli.commands.LibraryListReq")
 	proto.RegisterType((*LibraryListResp)(nil), "cc.arduino.cli.commands.LibraryListResp")
@@ -1158,75 +1374,84 @@ func init() {
 func init() { proto.RegisterFile("commands/lib.proto", fileDescriptor_9feed0d29806df6c) }
 
 var fileDescriptor_9feed0d29806df6c = []byte{
-	// 1107 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0xe7, 0xec, 0x36, 0xb1, 0xc7, 0x75, 0xed, 0x6c, 0xd3, 0xf6, 0x48, 0x81, 0x84, 0x13, 0x08,
-	0xb7, 0x28, 0x0e, 0x0a, 0x52, 0x85, 0x2a, 0x45, 0x28, 0xa8, 0x2d, 0x02, 0x45, 0x28, 0x3a, 0x28,
-	0x0f, 0x80, 0x74, 0x5a, 0xdf, 0x4d, 0xec, 0x95, 0xd7, 0x77, 0xd7, 0xdd, 0xbd, 0x54, 0xe6, 0x85,
-	0x3f, 0xdf, 0x80, 0x77, 0x1e, 0x78, 0x41, 0x48, 0x7c, 0x21, 0xbe, 0x0e, 0xda, 0x3f, 0x77, 0x3e,
-	0x3b, 0x4d, 0x1b, 0x50, 0x04, 0x88, 0xa7, 0xdc, 0xcc, 0xec, 0x6f, 0x66, 0x76, 0x66, 0x7e, 0xb3,
-	0x31, 0x90, 0x38, 0x9b, 0xcd, 0x68, 0x9a, 0xc8, 0x3d, 0xce, 0x46, 0xc3, 0x5c, 0x64, 0x2a, 0x23,
-	0xb7, 0xe3, 0x78, 0x48, 0x45, 0x52, 0xb0, 0x34, 0x1b, 0xc6, 0x9c, 0x0d, 0xcb, 0x23, 0x5b, 0x37,
-	0xab, 0xc3, 0xfa, 0x23, 0x4b, 0xed, 0xf9, 0xe0, 0x07, 0x0f, 0xc8, 0x11, 0x1b, 0x09, 0x2a, 0xe6,
-	0x0f, 0xb3, 0x67, 0x29, 0xcf, 0x68, 0x12, 0xe2, 0x53, 0x72, 0x00, 0x2d, 0x96, 0x4a, 0x45, 0xd3,
-	0x18, 0x7d, 0x6f, 0xc7, 0x1b, 0x74, 0xf6, 0xdf, 0x1c, 0x9e, 0xe3, 0x79, 0xf8, 0x89, 0x3b, 0x18,
-	0x56, 0x10, 0x42, 0xe0, 0x4a, 0x4a, 0x67, 0xe8, 0x37, 0x76, 0xbc, 0x41, 0x3b, 0x34, 0xdf, 0xc4,
-	0x87, 0xf5, 0x53, 0x14, 0x92, 0x65, 0xa9, 0xdf, 0x34, 0xea, 0x52, 0x0c, 0xbe, 0x81, 0x1b, 0x67,
-	0x52, 0x90, 0x39, 0x79, 0x04, 0xad, 0x5c, 0x64, 0x63, 0x81, 0x52, 0xba, 0x1c, 0xee, 0x9e, 0x9b,
-	0x43, 0x09, 0x3c, 0x76, 0x80, 0xb0, 0x82, 0x06, 0xdf, 0x7b, 0xb0, 0xe1, 0xdc, 0x9b, 0x4c, 0x39,
-	0xff, 0xc7, 0x2f, 0xf8, 0xdb, 0xa2, 0xc8, 0x55, 0x0a, 0x97, 0x76, 0x41, 0xf2, 0x29, 0x74, 0x15,
-	0x95, 0xd3, 0xa8, 0xf2, 0xd5, 0x30, 0xbe, 0xde, 0x3e, 0xd7, 0xd7, 0x17, 0x54, 0x4e, 0x2b, 0x3f,
-	0xd7, 0x54, 0x4d, 0x0a, 0x7e, 0xf4, 0xaa, 0x5e, 0x3c, 0x49, 0xd9, 0xbf, 0x54, 0xae, 0x11, 0x6c,
-	0x9e, 0xcd, 0x41, 0xe6, 0x67, 0x2f, 0xea, 0xfd, 0xfd, 0x8b, 0x3e, 0x59, 0xc4, 0xc8, 0xc7, 0x82,
-	0x26, 0x78, 0x78, 0x19, 0x17, 0x0d, 0x7e, 0xf7, 0xe0, 0xe6, 0x73, 0xfc, 0xfe, 0x37, 0x9b, 0x3d,
-	0x86, 0xbe, 0xcb, 0xf5, 0x73, 0xa4, 0x22, 0x9e, 0x5c, 0x42, 0xa3, 0x37, 0xe1, 0xea, 0xd3, 0x02,
-	0xc5, 0xdc, 0x75, 0xda, 0x0a, 0xc1, 0xd7, 0x15, 0x03, 0xcb, 0x40, 0x32, 0x27, 0x8f, 0xa1, 0xcd,
-	0x8d, 0x92, 0xa1, 0xae, 0x48, 0x73, 0xd0, 0xd9, 0x1f, 0x9c, 0x1b, 0xca, 0xe2, 0x30, 0x71, 0x6e,
-	0xc2, 0x05, 0x34, 0xf8, 0xa5, 0x01, 0xbd, 0x15, 0x73, 0x35, 0x6f, 0x5e, 0x6d, 0xde, 0x42, 0x68,
-	0x09, 0xe4, 0x48, 0x25, 0xea, 0xa2, 0xe9, 0x70, 0xf7, 0x2f, 0x1a, 0x6e, 0x18, 0x3a, 0xe0, 0xa3,
-	0x54, 0x89, 0x79, 0x58, 0xf9, 0x21, 0x1f, 0xc2, 0x1a, 0xa7, 0x0a, 0xa5, 0x32, 0x23, 0xdc, 0xd9,
-	0x7f, 0xe7, 0x5c, 0x8f, 0x65, 0xe2, 0x16, 0x19, 0x3a, 0xd8, 0x56, 0x02, 0xdd, 0x25, 0xdf, 0xa4,
-	0x0f, 0xcd, 0x29, 0xce, 0x5d, 0xe2, 0xfa, 0x93, 0x1c, 0xc0, 0xd5, 0x53, 0xca, 0x0b, 0x74, 0x9d,
-	0xbe, 0x70, 0x08, 0x8b, 0x7a, 0xd0, 0xf8, 0xc0, 0x0b, 0xfe, 0x68, 0xc0, 0xf5, 0x65, 0x2b, 0xb9,
-	0x05, 0x6b, 0xb4, 0x50, 0x93, 0x4c, 0xb8, 0x50, 0x4e, 0xaa, 0xb3, 0xb2, 0xb1, 0xc4, 0x4a, 0xf2,
-	0x06, 0xc0, 0x8c, 0xb2, 0x54, 0x51, 0x96, 0xa2, 0x70, 0x94, 0xad, 0x69, 0xc8, 0x16, 0xb4, 0x24,
-	0xa6, 0x0a, 0xf5, 0xe4, 0x5c, 0x31, 0xd6, 0x4a, 0x26, 0xaf, 0x41, 0x3b, 0xa7, 0x82, 0x8e, 0x05,
-	0xcd, 0x27, 0xfe, 0x55, 0x63, 0x5c, 0x28, 0x74, 0xcc, 0x67, 0x38, 0x92, 0x4c, 0xa1, 0xbf, 0x66,
-	0x63, 0x3a, 0x51, 0xfb, 0x8c, 0xa9, 0xc2, 0x71, 0x26, 0xe6, 0xfe, 0xba, 0xf5, 0x59, 0xca, 0xe4,
-	0x2d, 0xe8, 0xea, 0x26, 0x31, 0x85, 0xb1, 0x2a, 0x04, 0x4a, 0xbf, 0xb5, 0xd3, 0x1c, 0xb4, 0xc3,
-	0x65, 0xa5, 0x1e, 0x48, 0x35, 0xcf, 0x51, 0xfa, 0x6d, 0x63, 0xb5, 0x02, 0xf9, 0x18, 0xda, 0x02,
-	0x65, 0x56, 0x88, 0x18, 0xa5, 0x0f, 0x17, 0x64, 0x63, 0xe8, 0x10, 0xe1, 0x02, 0x1b, 0xfc, 0xec,
-	0x41, 0x7f, 0xd5, 0xae, 0x7b, 0x58, 0x08, 0x5e, 0xf6, 0xb0, 0x10, 0x9c, 0x0c, 0xa0, 0x67, 0xd2,
-	0x3a, 0xc5, 0x13, 0xc6, 0xb1, 0xb6, 0x0a, 0x57, 0xd5, 0xe6, 0xc6, 0x13, 0x8c, 0xa7, 0xb2, 0x98,
-	0xb9, 0x1a, 0x57, 0xb2, 0x9e, 0x6a, 0xc9, 0xbe, 0xb5, 0xd5, 0x6d, 0x86, 0xe6, 0x5b, 0x57, 0x36,
-	0xa6, 0xf1, 0x04, 0x73, 0xaa, 0xaa, 0xca, 0x56, 0x8a, 0xe0, 0xbb, 0xaa, 0xef, 0x47, 0x4c, 0xaa,
-	0x4b, 0xe0, 0x77, 0x1f, 0x9a, 0x94, 0x73, 0x93, 0x7c, 0x2b, 0xd4, 0x9f, 0x3a, 0x81, 0x22, 0x4f,
-	0xa8, 0xa2, 0x23, 0x8e, 0x26, 0xe3, 0x56, 0xb8, 0x50, 0x04, 0x0c, 0x7a, 0x4b, 0x09, 0xc8, 0x9c,
-	0x7c, 0x09, 0x1b, 0x6e, 0xa9, 0x63, 0x12, 0x59, 0x1a, 0xcf, 0x1d, 0xff, 0xef, 0xbe, 0x38, 0x15,
-	0x8d, 0x28, 0xc7, 0xb8, 0xcf, 0x56, 0x34, 0xc1, 0x4f, 0x1e, 0xf4, 0x57, 0x8f, 0x91, 0x07, 0xb0,
-	0xbe, 0x08, 0xa1, 0x6f, 0xbb, 0xf3, 0x52, 0xfa, 0x94, 0x00, 0x72, 0x08, 0xeb, 0x8e, 0xe8, 0x7f,
-	0x95, 0x7a, 0x25, 0x2e, 0xf8, 0x75, 0x0d, 0xd6, 0x5f, 0xb4, 0x93, 0x16, 0x2c, 0x6c, 0x2c, 0xb1,
-	0xf0, 0xff, 0xc4, 0xb5, 0x6d, 0xe8, 0xb8, 0x5e, 0x45, 0x09, 0x13, 0x86, 0x6d, 0xed, 0x10, 0x9c,
-	0xea, 0x21, 0x13, 0xe4, 0x75, 0x00, 0x4b, 0x1c, 0x63, 0xef, 0xd8, 0x8c, 0xad, 0x46, 0x9b, 0xb7,
-	0xa1, 0x53, 0x28, 0xc6, 0x99, 0x9a, 0x1b, 0xfb, 0x35, 0x8b, 0x77, 0x2a, 0x7d, 0x60, 0x0b, 0x5a,
-	0x3c, 0x8b, 0xa9, 0xd2, 0x3b, 0xab, 0x6b, 0x13, 0x2f, 0x65, 0xb2, 0xab, 0xff, 0x49, 0x76, 0x55,
-	0x8b, 0x72, 0x4e, 0xd5, 0x49, 0x26, 0x66, 0xfe, 0x75, 0x73, 0x6a, 0xa3, 0xb2, 0x1c, 0x3b, 0x83,
-	0xee, 0x07, 0xa7, 0xf3, 0xac, 0x50, 0x7e, 0xcf, 0xf6, 0xc3, 0x4a, 0xe4, 0x8e, 0xde, 0x17, 0x94,
-	0x47, 0xa6, 0x81, 0x7d, 0x1b, 0x43, 0x2b, 0x3e, 0xd3, 0x4d, 0x0c, 0xa0, 0x9b, 0x64, 0x2a, 0xa2,
-	0x11, 0x67, 0xe9, 0x94, 0x8e, 0xd1, 0xdf, 0x30, 0x2c, 0xe8, 0x24, 0x99, 0x3a, 0x3c, 0xb2, 0x2a,
-	0xb2, 0x03, 0x9d, 0x5c, 0x60, 0x9c, 0xcd, 0x72, 0xc6, 0x31, 0xf1, 0x89, 0x3d, 0x51, 0x53, 0x91,
-	0x57, 0xa1, 0xc5, 0x93, 0xe8, 0x84, 0xd3, 0xb1, 0xf4, 0x6f, 0xd8, 0xce, 0xf0, 0xe4, 0xb1, 0x16,
-	0x75, 0x74, 0x26, 0x23, 0x8e, 0x63, 0x1a, 0xcf, 0xfd, 0x4d, 0x03, 0x6d, 0x31, 0x79, 0x64, 0xe4,
-	0xfa, 0xc2, 0xbe, 0xb9, 0xbc, 0xb0, 0x7d, 0x3d, 0xfb, 0x31, 0xa6, 0x12, 0xfd, 0x5b, 0xce, 0xa1,
-	0x15, 0xc9, 0x31, 0x40, 0x2e, 0xb2, 0x1c, 0x85, 0xd2, 0x6f, 0xef, 0x6d, 0xc3, 0xbd, 0xf7, 0x5e,
-	0x36, 0xdc, 0xc3, 0xe3, 0x0a, 0x62, 0x9f, 0xc1, 0x9a, 0x8f, 0xad, 0x03, 0xe8, 0xad, 0x98, 0x9f,
-	0xf3, 0x92, 0x6d, 0xd6, 0x5f, 0xb2, 0x76, 0xed, 0x81, 0xba, 0x77, 0x1f, 0xba, 0xe5, 0x9a, 0xb0,
-	0x05, 0xef, 0x41, 0xe7, 0x84, 0x53, 0x15, 0xd9, 0xfa, 0xf7, 0x5f, 0x21, 0x9b, 0xd0, 0x17, 0x18,
-	0x17, 0x42, 0xb2, 0x53, 0x2c, 0xb5, 0xde, 0xbd, 0xda, 0x7a, 0x29, 0x3b, 0xde, 0x83, 0x0e, 0x4b,
-	0x30, 0x1a, 0x15, 0x8c, 0x2b, 0x96, 0x5a, 0x64, 0xd9, 0xf8, 0x4a, 0xeb, 0x91, 0x6d, 0xb8, 0x23,
-	0xf0, 0x04, 0x85, 0xa6, 0x4c, 0x12, 0x9d, 0x39, 0xd0, 0x20, 0xd7, 0x01, 0xe4, 0x14, 0x55, 0x3c,
-	0x19, 0x65, 0xd9, 0xb4, 0xdf, 0xfc, 0x68, 0xf7, 0xab, 0x77, 0xc7, 0x4c, 0x4d, 0x8a, 0x91, 0x2e,
-	0xcc, 0x9e, 0x2b, 0x54, 0xf9, 0x77, 0x37, 0xe6, 0x6c, 0x4f, 0xe4, 0xf1, 0x5e, 0x59, 0xb4, 0xd1,
-	0x9a, 0xf9, 0x79, 0xf5, 0xfe, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x56, 0xb0, 0x4f, 0x99, 0xa4,
-	0x0d, 0x00, 0x00,
+	// 1257 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x6b, 0x1b, 0xc7,
+	0x17, 0xff, 0xae, 0x94, 0xd8, 0xd2, 0x53, 0x1c, 0xc9, 0x13, 0x27, 0xde, 0xaf, 0xd3, 0x24, 0xea,
+	0xd2, 0x52, 0x25, 0x21, 0x72, 0x49, 0x21, 0x94, 0x40, 0x28, 0x69, 0x9d, 0x94, 0x14, 0x13, 0xcc,
+	0xe6, 0xc7, 0xa1, 0x2d, 0x2c, 0xa3, 0xdd, 0x67, 0x69, 0xd0, 0x68, 0x67, 0x33, 0x33, 0xeb, 0xa0,
+	0x5e, 0x4a, 0x0b, 0xbd, 0xf5, 0xd2, 0x63, 0xa1, 0x87, 0x5e, 0x4a, 0xa0, 0x7f, 0x65, 0x99, 0x9d,
+	0xd9, 0xd5, 0x0f, 0xdb, 0x89, 0x53, 0x4c, 0x5a, 0x7a, 0xf2, 0xbe, 0xcf, 0x9b, 0xf7, 0xde, 0x67,
+	0xe6, 0xfd, 0x92, 0x81, 0xc4, 0x62, 0x32, 0xa1, 0x69, 0xa2, 0xb6, 0x39, 0x1b, 0xf4, 0x33, 0x29,
+	0xb4, 0x20, 0x9b, 0x71, 0xdc, 0xa7, 0x32, 0xc9, 0x59, 0x2a, 0xfa, 0x31, 0x67, 0xfd, 0xf2, 0xc8,
+	0xd6, 0xc5, 0xea, 0xb0, 0xf9, 0x10, 0xa9, 0x3d, 0x1f, 0xfc, 0xe0, 0x01, 0xd9, 0x65, 0x03, 0x49,
+	0xe5, 0x74, 0x47, 0xbc, 0x4c, 0xb9, 0xa0, 0x49, 0x88, 0x2f, 0xc8, 0x3d, 0x68, 0xb0, 0x54, 0x69,
+	0x9a, 0xc6, 0xe8, 0x7b, 0x5d, 0xaf, 0xd7, 0xba, 0xfd, 0x7e, 0xff, 0x18, 0xcf, 0xfd, 0x47, 0xee,
+	0x60, 0x58, 0x99, 0x10, 0x02, 0x67, 0x52, 0x3a, 0x41, 0xbf, 0xd6, 0xf5, 0x7a, 0xcd, 0xb0, 0xf8,
+	0x26, 0x3e, 0xac, 0x1e, 0xa0, 0x54, 0x4c, 0xa4, 0x7e, 0xbd, 0x80, 0x4b, 0x31, 0xf8, 0x16, 0x2e,
+	0x1c, 0xa2, 0xa0, 0x32, 0xf2, 0x00, 0x1a, 0x99, 0x14, 0x43, 0x89, 0x4a, 0x39, 0x0e, 0xd7, 0x8f,
+	0xe5, 0x50, 0x1a, 0xee, 0x39, 0x83, 0xb0, 0x32, 0x0d, 0x7e, 0xf5, 0x60, 0xdd, 0xb9, 0x2f, 0x98,
+	0x72, 0xfe, 0xae, 0x2f, 0x48, 0x36, 0x61, 0x35, 0x15, 0x51, 0x82, 0x99, 0xf2, 0xcf, 0x74, 0xbd,
+	0x5e, 0x23, 0x5c, 0x49, 0xc5, 0x0e, 0x66, 0x2a, 0x78, 0x35, 0x7b, 0xfd, 0x8a, 0xdb, 0xa9, 0xdd,
+	0x9c, 0x7c, 0x05, 0x6b, 0x9a, 0xaa, 0x71, 0x54, 0xf9, 0xaa, 0x15, 0xbe, 0x3e, 0x3c, 0xd6, 0xd7,
+	0x53, 0xaa, 0xc6, 0x95, 0x9f, 0x73, 0x7a, 0x4e, 0x0a, 0x7e, 0xf6, 0xe0, 0x8a, 0x63, 0x1a, 0xa2,
+	0x12, 0xfc, 0x00, 0x77, 0x30, 0xc3, 0x34, 0xc1, 0x34, 0x66, 0xa8, 0xde, 0x79, 0xc9, 0x1c, 0xc0,
+	0xd5, 0xd7, 0xb1, 0x51, 0x19, 0x79, 0x0a, 0xe7, 0x92, 0x39, 0xcc, 0xf7, 0xba, 0xf5, 0x5e, 0xeb,
+	0xf6, 0xc7, 0xc7, 0x52, 0x2a, 0x2b, 0xb0, 0xb4, 0x99, 0x3e, 0xd1, 0x54, 0xe7, 0x2a, 0x5c, 0xf0,
+	0x12, 0xfc, 0xe4, 0xc1, 0xe6, 0x31, 0x27, 0xab, 0x1b, 0x78, 0x73, 0x37, 0xb8, 0x0e, 0x1d, 0x47,
+	0x39, 0x92, 0xf8, 0x22, 0x67, 0x12, 0x13, 0x77, 0xc3, 0xb6, 0xc3, 0x43, 0x07, 0x93, 0x9b, 0xb0,
+	0x5e, 0x1e, 0x65, 0xb6, 0x16, 0x30, 0x71, 0xd7, 0x2e, 0x7d, 0x3c, 0x2a, 0xf1, 0xe0, 0x47, 0xaf,
+	0xea, 0x99, 0x67, 0x29, 0xfb, 0x67, 0xca, 0x3a, 0x18, 0xc0, 0xc6, 0x61, 0x0e, 0x2a, 0x3b, 0x5c,
+	0x77, 0xde, 0xdf, 0xaf, 0xbb, 0x67, 0xb3, 0x18, 0xd9, 0x50, 0xd2, 0x04, 0xef, 0x9f, 0xc6, 0x45,
+	0x83, 0x3f, 0x3d, 0xb8, 0x78, 0x84, 0xdf, 0x7f, 0x67, 0xef, 0x0d, 0xa1, 0xe3, 0xb8, 0x3e, 0x41,
+	0x2a, 0xe3, 0xd1, 0x29, 0x24, 0x7a, 0x03, 0xce, 0xbe, 0xc8, 0x51, 0x4e, 0x5d, 0xa6, 0xad, 0x10,
+	0x7c, 0x03, 0xeb, 0x4b, 0x81, 0x54, 0x46, 0x1e, 0x42, 0x93, 0x17, 0xe0, 0xac, 0x8b, 0x7a, 0xc7,
+	0x86, 0xb2, 0x76, 0x98, 0x94, 0xcd, 0x39, 0x33, 0x0d, 0x7e, 0xaf, 0x41, 0x7b, 0x49, 0x7d, 0x64,
+	0xcb, 0x84, 0xd0, 0x90, 0xc8, 0x91, 0x2a, 0x34, 0x8f, 0x66, 0xc2, 0xdd, 0x39, 0x69, 0xb8, 0x7e,
+	0xe8, 0x0c, 0x1f, 0xa4, 0x5a, 0x4e, 0xc3, 0xca, 0x0f, 0xf9, 0x0c, 0x56, 0x38, 0xd5, 0xa8, 0x74,
+	0x51, 0xc2, 0xad, 0xdb, 0x1f, 0xbd, 0x69, 0x0c, 0x38, 0x47, 0xa1, 0x33, 0xdb, 0x4a, 0x60, 0x6d,
+	0xc1, 0x37, 0xe9, 0x40, 0x7d, 0x8c, 0x53, 0x47, 0xdc, 0x7c, 0x92, 0x7b, 0x70, 0xf6, 0x80, 0xf2,
+	0x1c, 0xfd, 0xda, 0xdb, 0x85, 0xb0, 0x56, 0x77, 0x6b, 0x9f, 0x7a, 0xc1, 0xab, 0x3a, 0x9c, 0x5f,
+	0xd4, 0x92, 0x4b, 0xb0, 0x42, 0x73, 0x3d, 0x12, 0xd2, 0x85, 0x72, 0xd2, 0x7c, 0x57, 0xd6, 0x16,
+	0x97, 0xcd, 0x55, 0x80, 0x09, 0x65, 0xa9, 0xa6, 0x2c, 0x45, 0xe9, 0x5a, 0x76, 0x0e, 0x21, 0x5b,
+	0xd0, 0x50, 0x98, 0x6a, 0x34, 0x95, 0x73, 0xa6, 0xd0, 0x56, 0x32, 0x79, 0x0f, 0x9a, 0x19, 0x95,
+	0x74, 0x28, 0x69, 0x36, 0xf2, 0xcf, 0x16, 0xca, 0x19, 0x60, 0x62, 0xbe, 0xc4, 0x81, 0x62, 0x1a,
+	0xfd, 0x15, 0x1b, 0xd3, 0x89, 0xc6, 0x67, 0x4c, 0x35, 0x0e, 0x85, 0x9c, 0xfa, 0xab, 0xd6, 0x67,
+	0x29, 0x93, 0x0f, 0x60, 0xcd, 0x24, 0x89, 0x69, 0x8c, 0x75, 0x2e, 0x51, 0xf9, 0x8d, 0x6e, 0xbd,
+	0xd7, 0x0c, 0x17, 0x41, 0x53, 0x90, 0x7a, 0x9a, 0xa1, 0xf2, 0x9b, 0x85, 0xd6, 0x0a, 0xe4, 0x4b,
+	0x68, 0x4a, 0x54, 0x22, 0x97, 0x31, 0x2a, 0x1f, 0x4e, 0xd8, 0x8d, 0xa1, 0xb3, 0x08, 0x67, 0xb6,
+	0xe4, 0xf1, 0xd2, 0x36, 0x68, 0x15, 0x85, 0x75, 0xe3, 0xe4, 0xdb, 0x60, 0x69, 0x0f, 0x3c, 0x87,
+	0xf5, 0x43, 0x47, 0x8e, 0xac, 0xe6, 0x5b, 0x40, 0xca, 0xa9, 0x1e, 0x8b, 0x54, 0x69, 0x69, 0x12,
+	0xe1, 0x52, 0x56, 0xce, 0xfb, 0x2f, 0x2a, 0x45, 0xf0, 0x9b, 0x07, 0x9d, 0xe5, 0x7b, 0x98, 0x5a,
+	0xcb, 0x25, 0x2f, 0x6b, 0x2d, 0x97, 0x9c, 0xf4, 0xa0, 0x5d, 0x3c, 0xdf, 0x01, 0xee, 0x33, 0x8e,
+	0x73, 0x23, 0x7b, 0x19, 0x2e, 0x32, 0x33, 0xc2, 0x78, 0xac, 0xf2, 0x89, 0xab, 0x85, 0x4a, 0x36,
+	0x7c, 0x15, 0xfb, 0xce, 0x56, 0x41, 0x3d, 0x2c, 0xbe, 0x4d, 0x05, 0xc4, 0x34, 0x1e, 0x61, 0x46,
+	0x75, 0x55, 0x01, 0x15, 0x10, 0x7c, 0x5f, 0xd5, 0xe7, 0x2e, 0x53, 0xfa, 0x14, 0xe6, 0x50, 0x07,
+	0xea, 0x94, 0xf3, 0x82, 0x7c, 0x23, 0x34, 0x9f, 0x86, 0x40, 0x9e, 0x25, 0x54, 0xd3, 0x01, 0xc7,
+	0x82, 0x71, 0x23, 0x9c, 0x01, 0x01, 0x83, 0xf6, 0x02, 0x01, 0x95, 0x91, 0xe7, 0xb0, 0x5e, 0xed,
+	0xcb, 0xc8, 0x8e, 0x9b, 0xa9, 0x9b, 0x53, 0xd7, 0x5f, 0x4f, 0xc5, 0x58, 0x94, 0xed, 0xd6, 0x61,
+	0x4b, 0x48, 0xf0, 0x8b, 0x07, 0x9d, 0xe5, 0x63, 0xe4, 0x2e, 0xac, 0xce, 0x42, 0x98, 0xdb, 0x76,
+	0xdf, 0xd8, 0xe6, 0xa5, 0x01, 0xb9, 0x0f, 0xab, 0x6e, 0x20, 0xbd, 0xed, 0x88, 0x28, 0xed, 0x82,
+	0x3f, 0x56, 0x60, 0xf5, 0x75, 0xb3, 0x73, 0x36, 0x2d, 0x6a, 0x0b, 0xd3, 0xe2, 0xbf, 0x34, 0x13,
+	0xae, 0x41, 0xcb, 0xe5, 0x2a, 0x4a, 0x98, 0x2c, 0xa6, 0x42, 0x33, 0x04, 0x07, 0xed, 0x30, 0x49,
+	0xae, 0x00, 0xd8, 0xc6, 0x29, 0xf4, 0x2d, 0xcb, 0xd8, 0x22, 0x46, 0x7d, 0x0d, 0x5a, 0xb9, 0x66,
+	0x9c, 0xe9, 0x69, 0xa1, 0x3f, 0x67, 0xed, 0x1d, 0x64, 0x0e, 0x6c, 0x41, 0x83, 0x8b, 0x98, 0x6a,
+	0x33, 0x5b, 0xd7, 0x2c, 0xf1, 0x52, 0x36, 0xed, 0x1c, 0x0b, 0xf7, 0x6a, 0x51, 0xc6, 0xa9, 0xde,
+	0x17, 0x72, 0xe2, 0x9f, 0xb7, 0xed, 0x5c, 0x69, 0xf6, 0x9c, 0xc2, 0xe4, 0x83, 0xd3, 0xa9, 0xc8,
+	0xb5, 0xdf, 0xb6, 0xf9, 0xb0, 0x12, 0xb9, 0x6c, 0xe6, 0x1a, 0xe5, 0x51, 0x91, 0xc0, 0x8e, 0x8d,
+	0x61, 0x80, 0xc7, 0x26, 0x89, 0x01, 0xac, 0x25, 0x42, 0x47, 0x34, 0xe2, 0x2c, 0x1d, 0xd3, 0x21,
+	0xfa, 0xeb, 0x45, 0x17, 0xb4, 0x12, 0xa1, 0xef, 0xef, 0x5a, 0x88, 0x74, 0xa1, 0x95, 0x49, 0x8c,
+	0xc5, 0x24, 0x63, 0xe6, 0x67, 0x22, 0xb1, 0x27, 0xe6, 0x20, 0xf2, 0x7f, 0x68, 0xf0, 0x24, 0xda,
+	0xe7, 0x74, 0xa8, 0xfc, 0x0b, 0x36, 0x33, 0x3c, 0x79, 0x68, 0x44, 0x13, 0x9d, 0xa9, 0x88, 0xe3,
+	0x90, 0xc6, 0x53, 0x7f, 0xa3, 0x30, 0x6d, 0x30, 0xb5, 0x5b, 0xc8, 0xf3, 0x8b, 0xe5, 0xe2, 0xe2,
+	0x62, 0xf1, 0x4d, 0xed, 0xc7, 0x98, 0x2a, 0xf4, 0x2f, 0x39, 0x87, 0x56, 0x24, 0x7b, 0x00, 0x99,
+	0x14, 0x19, 0x4a, 0x6d, 0x66, 0xeb, 0xe6, 0xc9, 0x7e, 0x69, 0xf7, 0xf7, 0x2a, 0x13, 0xbb, 0xae,
+	0xe7, 0x7c, 0x6c, 0xdd, 0x83, 0xf6, 0x92, 0xfa, 0x88, 0x8d, 0xbb, 0x31, 0xbf, 0x71, 0x9b, 0x73,
+	0x8b, 0xf4, 0xc6, 0x1d, 0x58, 0x2b, 0xc7, 0x84, 0x7d, 0xf0, 0x36, 0xb4, 0xf6, 0x39, 0xd5, 0x91,
+	0x7d, 0xff, 0xce, 0xff, 0xc8, 0x06, 0x74, 0x24, 0xc6, 0xb9, 0x54, 0xec, 0x00, 0x4b, 0xd4, 0xbb,
+	0x31, 0x37, 0x5e, 0xca, 0x8c, 0xb7, 0xa1, 0xc5, 0x12, 0x8c, 0x06, 0x39, 0xe3, 0x9a, 0xa5, 0xd6,
+	0xb2, 0x4c, 0x7c, 0x85, 0x7a, 0xe4, 0x1a, 0x5c, 0x96, 0xb8, 0x8f, 0xd2, 0xb4, 0x4c, 0x12, 0x1d,
+	0x3a, 0x50, 0x23, 0xe7, 0x01, 0xd4, 0x18, 0x75, 0x3c, 0x1a, 0x08, 0x31, 0xee, 0xd4, 0x3f, 0xbf,
+	0xf5, 0xf5, 0xcd, 0x21, 0xd3, 0xa3, 0x7c, 0x60, 0x1e, 0x66, 0xdb, 0x3d, 0x54, 0xf9, 0xf7, 0x56,
+	0xcc, 0xd9, 0xb6, 0xcc, 0xe2, 0xed, 0xf2, 0xd1, 0x06, 0x2b, 0xc5, 0xbf, 0xeb, 0x9f, 0xfc, 0x35,
+	0x00, 0x78, 0xc0, 0x73, 0x72, 0xf4, 0x0f, 0x00, 0x00,
 }
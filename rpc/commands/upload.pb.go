@@ -20,17 +20,99 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type UploadProgress_Phase int32
+
+const (
+	// The board is being reset with a 1200bps touch.
+	UploadProgress_RESET UploadProgress_Phase = 0
+	// Waiting for the upload port to appear after the reset.
+	UploadProgress_WAIT_FOR_PORT UploadProgress_Phase = 1
+	// The upload port has been detected (see port).
+	UploadProgress_NEW_PORT UploadProgress_Phase = 2
+	// The upload tool has been started.
+	UploadProgress_TOOL_START UploadProgress_Phase = 3
+	// The upload tool reported the completion of an operation (see
+	// operation and percent), sent only if parse_progress is set.
+	UploadProgress_TOOL_PROGRESS UploadProgress_Phase = 4
+	// The upload tool exited (see exit_code).
+	UploadProgress_TOOL_EXIT UploadProgress_Phase = 5
+	// The flash is being read back to verify the upload.
+	UploadProgress_VERIFY UploadProgress_Phase = 6
+)
+
+var UploadProgress_Phase_name = map[int32]string{
+	0: "RESET",
+	1: "WAIT_FOR_PORT",
+	2: "NEW_PORT",
+	3: "TOOL_START",
+	4: "TOOL_PROGRESS",
+	5: "TOOL_EXIT",
+	6: "VERIFY",
+}
+
+var UploadProgress_Phase_value = map[string]int32{
+	"RESET":         0,
+	"WAIT_FOR_PORT": 1,
+	"NEW_PORT":      2,
+	"TOOL_START":    3,
+	"TOOL_PROGRESS": 4,
+	"TOOL_EXIT":     5,
+	"VERIFY":        6,
+}
+
+func (x UploadProgress_Phase) String() string {
+	return proto.EnumName(UploadProgress_Phase_name, int32(x))
+}
+
+func (UploadProgress_Phase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cd642cc079f8acdb, []int{1, 0}
+}
+
 type UploadReq struct {
-	Instance             *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
-	Fqbn                 string    `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
-	SketchPath           string    `protobuf:"bytes,3,opt,name=sketch_path,json=sketchPath,proto3" json:"sketch_path,omitempty"`
-	Port                 string    `protobuf:"bytes,4,opt,name=port,proto3" json:"port,omitempty"`
-	Verbose              bool      `protobuf:"varint,5,opt,name=verbose,proto3" json:"verbose,omitempty"`
-	Verify               bool      `protobuf:"varint,6,opt,name=verify,proto3" json:"verify,omitempty"`
-	ImportFile           string    `protobuf:"bytes,7,opt,name=import_file,json=importFile,proto3" json:"import_file,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Instance   *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	Fqbn       string    `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	SketchPath string    `protobuf:"bytes,3,opt,name=sketch_path,json=sketchPath,proto3" json:"sketch_path,omitempty"`
+	Port       string    `protobuf:"bytes,4,opt,name=port,proto3" json:"port,omitempty"`
+	Verbose    bool      `protobuf:"varint,5,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	Verify     bool      `protobuf:"varint,6,opt,name=verify,proto3" json:"verify,omitempty"`
+	ImportFile string    `protobuf:"bytes,7,opt,name=import_file,json=importFile,proto3" json:"import_file,omitempty"`
+	Programmer string    `protobuf:"bytes,8,opt,name=programmer,proto3" json:"programmer,omitempty"`
+	// Password used by the network upload recipes (`network.password`
+	// property), for boards that require authentication.
+	NetworkPassword string `protobuf:"bytes,9,opt,name=network_password,json=networkPassword,proto3" json:"network_password,omitempty"`
+	// Folder containing the binary to upload, as an alternative to
+	// `import_file`. The binary is selected using the extension expected
+	// by the board, `*.with_bootloader.*` files are preferred when using a
+	// programmer and are otherwise used only if no other binary is found.
+	ImportDir string `protobuf:"bytes,10,opt,name=import_dir,json=importDir,proto3" json:"import_dir,omitempty"`
+	// Resolve the board, the tools and the upload recipe without running
+	// the upload tool. The result is returned in the UploadResp, with the
+	// network password masked.
+	DryRun bool `protobuf:"varint,11,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Maximum time allowed to the upload tool to complete, in milliseconds.
+	// The tool is killed when the timeout expires. 0 means no timeout.
+	TimeoutMs int32 `protobuf:"varint,12,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	// Parse the output of the well-known upload tools (avrdude, bossac and
+	// esptool) to send TOOL_PROGRESS events with the percentage of
+	// completion.
+	ParseProgress bool `protobuf:"varint,13,opt,name=parse_progress,json=parseProgress,proto3" json:"parse_progress,omitempty"`
+	// After the upload, read back the flash of the board and compare it
+	// with the uploaded binary. The flash is read with the
+	// `upload.readback.pattern` recipe (`program.readback.pattern` when
+	// using a programmer) or with the read command of avrdude, bossac or
+	// esptool. The boards reset with a 1200bps touch before the upload are
+	// reset again before reading the flash.
+	ReadbackVerify bool `protobuf:"varint,14,opt,name=readback_verify,json=readbackVerify,proto3" json:"readback_verify,omitempty"`
+	// Read the flash of the board into this file instead of uploading. The
+	// format (Intel HEX or raw binary) depends on the file extension, bossac
+	// and esptool support only raw binary files.
+	ReadbackFile string `protobuf:"bytes,15,opt,name=readback_file,json=readbackFile,proto3" json:"readback_file,omitempty"`
+	// Use the FQBN of this build profile of the sketch, if fqbn is not
+	// specified. The default profile is used if empty.
+	Profile              string   `protobuf:"bytes,16,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UploadReq) Reset()         { *m = UploadReq{} }
@@ -107,19 +189,187 @@ func (m *UploadReq) GetImportFile() string {
 	return ""
 }
 
-type UploadResp struct {
-	OutStream            []byte   `protobuf:"bytes,1,opt,name=out_stream,json=outStream,proto3" json:"out_stream,omitempty"`
-	ErrStream            []byte   `protobuf:"bytes,2,opt,name=err_stream,json=errStream,proto3" json:"err_stream,omitempty"`
+func (m *UploadReq) GetProgrammer() string {
+	if m != nil {
+		return m.Programmer
+	}
+	return ""
+}
+
+func (m *UploadReq) GetNetworkPassword() string {
+	if m != nil {
+		return m.NetworkPassword
+	}
+	return ""
+}
+
+func (m *UploadReq) GetImportDir() string {
+	if m != nil {
+		return m.ImportDir
+	}
+	return ""
+}
+
+func (m *UploadReq) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *UploadReq) GetTimeoutMs() int32 {
+	if m != nil {
+		return m.TimeoutMs
+	}
+	return 0
+}
+
+func (m *UploadReq) GetParseProgress() bool {
+	if m != nil {
+		return m.ParseProgress
+	}
+	return false
+}
+
+func (m *UploadReq) GetReadbackVerify() bool {
+	if m != nil {
+		return m.ReadbackVerify
+	}
+	return false
+}
+
+func (m *UploadReq) GetReadbackFile() string {
+	if m != nil {
+		return m.ReadbackFile
+	}
+	return ""
+}
+
+func (m *UploadReq) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
+type UploadProgress struct {
+	Phase UploadProgress_Phase `protobuf:"varint,1,opt,name=phase,proto3,enum=cc.arduino.cli.commands.UploadProgress_Phase" json:"phase,omitempty"`
+	// Human readable description of the event.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// True when the phase is completed.
+	Completed bool `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	// The upload port, for NEW_PORT events.
+	Port string `protobuf:"bytes,4,opt,name=port,proto3" json:"port,omitempty"`
+	// The operation in progress, for TOOL_PROGRESS events: "erasing",
+	// "writing", "reading" or "verifying".
+	Operation string `protobuf:"bytes,5,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Percentage of completion of the operation, for TOOL_PROGRESS events.
+	Percent float32 `protobuf:"fixed32,6,opt,name=percent,proto3" json:"percent,omitempty"`
+	// Exit code of the upload tool, for TOOL_EXIT events (-1 if the tool
+	// has been killed).
+	ExitCode             int32    `protobuf:"varint,7,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *UploadProgress) Reset()         { *m = UploadProgress{} }
+func (m *UploadProgress) String() string { return proto.CompactTextString(m) }
+func (*UploadProgress) ProtoMessage()    {}
+func (*UploadProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd642cc079f8acdb, []int{1}
+}
+
+func (m *UploadProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadProgress.Unmarshal(m, b)
+}
+func (m *UploadProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadProgress.Marshal(b, m, deterministic)
+}
+func (m *UploadProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadProgress.Merge(m, src)
+}
+func (m *UploadProgress) XXX_Size() int {
+	return xxx_messageInfo_UploadProgress.Size(m)
+}
+func (m *UploadProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadProgress proto.InternalMessageInfo
+
+func (m *UploadProgress) GetPhase() UploadProgress_Phase {
+	if m != nil {
+		return m.Phase
+	}
+	return UploadProgress_RESET
+}
+
+func (m *UploadProgress) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *UploadProgress) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+func (m *UploadProgress) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *UploadProgress) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *UploadProgress) GetPercent() float32 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
+func (m *UploadProgress) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+type UploadResp struct {
+	OutStream []byte `protobuf:"bytes,1,opt,name=out_stream,json=outStream,proto3" json:"out_stream,omitempty"`
+	ErrStream []byte `protobuf:"bytes,2,opt,name=err_stream,json=errStream,proto3" json:"err_stream,omitempty"`
+	// The command line that would have been executed, only for dry runs.
+	CommandLine []string `protobuf:"bytes,3,rep,name=command_line,json=commandLine,proto3" json:"command_line,omitempty"`
+	// The paths of the tools used for the upload, indexed by tool name
+	// (the runtime.tools.*.path properties), only for dry runs.
+	ToolPaths map[string]string `protobuf:"bytes,4,rep,name=tool_paths,json=toolPaths,proto3" json:"tool_paths,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The properties used to build the upload command line, only for dry
+	// runs.
+	Properties map[string]string `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Progress of the upload.
+	Progress             *UploadProgress `protobuf:"bytes,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
 func (m *UploadResp) Reset()         { *m = UploadResp{} }
 func (m *UploadResp) String() string { return proto.CompactTextString(m) }
 func (*UploadResp) ProtoMessage()    {}
 func (*UploadResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd642cc079f8acdb, []int{1}
+	return fileDescriptor_cd642cc079f8acdb, []int{2}
 }
 
 func (m *UploadResp) XXX_Unmarshal(b []byte) error {
@@ -154,32 +404,347 @@ func (m *UploadResp) GetErrStream() []byte {
 	return nil
 }
 
+func (m *UploadResp) GetCommandLine() []string {
+	if m != nil {
+		return m.CommandLine
+	}
+	return nil
+}
+
+func (m *UploadResp) GetToolPaths() map[string]string {
+	if m != nil {
+		return m.ToolPaths
+	}
+	return nil
+}
+
+func (m *UploadResp) GetProperties() map[string]string {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *UploadResp) GetProgress() *UploadProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type MultiUploadReq struct {
+	// The upload parameters shared by all the boards, the port is ignored.
+	Upload *UploadReq `protobuf:"bytes,1,opt,name=upload,proto3" json:"upload,omitempty"`
+	// The upload ports, one for each board.
+	Ports                []string `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiUploadReq) Reset()         { *m = MultiUploadReq{} }
+func (m *MultiUploadReq) String() string { return proto.CompactTextString(m) }
+func (*MultiUploadReq) ProtoMessage()    {}
+func (*MultiUploadReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd642cc079f8acdb, []int{3}
+}
+
+func (m *MultiUploadReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiUploadReq.Unmarshal(m, b)
+}
+func (m *MultiUploadReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiUploadReq.Marshal(b, m, deterministic)
+}
+func (m *MultiUploadReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiUploadReq.Merge(m, src)
+}
+func (m *MultiUploadReq) XXX_Size() int {
+	return xxx_messageInfo_MultiUploadReq.Size(m)
+}
+func (m *MultiUploadReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiUploadReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiUploadReq proto.InternalMessageInfo
+
+func (m *MultiUploadReq) GetUpload() *UploadReq {
+	if m != nil {
+		return m.Upload
+	}
+	return nil
+}
+
+func (m *MultiUploadReq) GetPorts() []string {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+type MultiUploadResp struct {
+	// The port of the board this response refers to.
+	Port string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	// The output, the progress and the result of the upload on the port.
+	Upload *UploadResp `protobuf:"bytes,2,opt,name=upload,proto3" json:"upload,omitempty"`
+	// True when the upload on the port is terminated.
+	Completed bool `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	// The error that made the upload on the port fail, empty on success.
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiUploadResp) Reset()         { *m = MultiUploadResp{} }
+func (m *MultiUploadResp) String() string { return proto.CompactTextString(m) }
+func (*MultiUploadResp) ProtoMessage()    {}
+func (*MultiUploadResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd642cc079f8acdb, []int{4}
+}
+
+func (m *MultiUploadResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiUploadResp.Unmarshal(m, b)
+}
+func (m *MultiUploadResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiUploadResp.Marshal(b, m, deterministic)
+}
+func (m *MultiUploadResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiUploadResp.Merge(m, src)
+}
+func (m *MultiUploadResp) XXX_Size() int {
+	return xxx_messageInfo_MultiUploadResp.Size(m)
+}
+func (m *MultiUploadResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiUploadResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiUploadResp proto.InternalMessageInfo
+
+func (m *MultiUploadResp) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *MultiUploadResp) GetUpload() *UploadResp {
+	if m != nil {
+		return m.Upload
+	}
+	return nil
+}
+
+func (m *MultiUploadResp) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+func (m *MultiUploadResp) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type BurnBootloaderReq struct {
+	Instance             *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	Fqbn                 string    `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	Port                 string    `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	Verbose              bool      `protobuf:"varint,4,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	Verify               bool      `protobuf:"varint,5,opt,name=verify,proto3" json:"verify,omitempty"`
+	Programmer           string    `protobuf:"bytes,6,opt,name=programmer,proto3" json:"programmer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *BurnBootloaderReq) Reset()         { *m = BurnBootloaderReq{} }
+func (m *BurnBootloaderReq) String() string { return proto.CompactTextString(m) }
+func (*BurnBootloaderReq) ProtoMessage()    {}
+func (*BurnBootloaderReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd642cc079f8acdb, []int{5}
+}
+
+func (m *BurnBootloaderReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BurnBootloaderReq.Unmarshal(m, b)
+}
+func (m *BurnBootloaderReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BurnBootloaderReq.Marshal(b, m, deterministic)
+}
+func (m *BurnBootloaderReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnBootloaderReq.Merge(m, src)
+}
+func (m *BurnBootloaderReq) XXX_Size() int {
+	return xxx_messageInfo_BurnBootloaderReq.Size(m)
+}
+func (m *BurnBootloaderReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnBootloaderReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnBootloaderReq proto.InternalMessageInfo
+
+func (m *BurnBootloaderReq) GetInstance() *Instance {
+	if m != nil {
+		return m.Instance
+	}
+	return nil
+}
+
+func (m *BurnBootloaderReq) GetFqbn() string {
+	if m != nil {
+		return m.Fqbn
+	}
+	return ""
+}
+
+func (m *BurnBootloaderReq) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *BurnBootloaderReq) GetVerbose() bool {
+	if m != nil {
+		return m.Verbose
+	}
+	return false
+}
+
+func (m *BurnBootloaderReq) GetVerify() bool {
+	if m != nil {
+		return m.Verify
+	}
+	return false
+}
+
+func (m *BurnBootloaderReq) GetProgrammer() string {
+	if m != nil {
+		return m.Programmer
+	}
+	return ""
+}
+
+type BurnBootloaderResp struct {
+	OutStream            []byte   `protobuf:"bytes,1,opt,name=out_stream,json=outStream,proto3" json:"out_stream,omitempty"`
+	ErrStream            []byte   `protobuf:"bytes,2,opt,name=err_stream,json=errStream,proto3" json:"err_stream,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BurnBootloaderResp) Reset()         { *m = BurnBootloaderResp{} }
+func (m *BurnBootloaderResp) String() string { return proto.CompactTextString(m) }
+func (*BurnBootloaderResp) ProtoMessage()    {}
+func (*BurnBootloaderResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd642cc079f8acdb, []int{6}
+}
+
+func (m *BurnBootloaderResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BurnBootloaderResp.Unmarshal(m, b)
+}
+func (m *BurnBootloaderResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BurnBootloaderResp.Marshal(b, m, deterministic)
+}
+func (m *BurnBootloaderResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnBootloaderResp.Merge(m, src)
+}
+func (m *BurnBootloaderResp) XXX_Size() int {
+	return xxx_messageInfo_BurnBootloaderResp.Size(m)
+}
+func (m *BurnBootloaderResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnBootloaderResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnBootloaderResp proto.InternalMessageInfo
+
+func (m *BurnBootloaderResp) GetOutStream() []byte {
+	if m != nil {
+		return m.OutStream
+	}
+	return nil
+}
+
+func (m *BurnBootloaderResp) GetErrStream() []byte {
+	if m != nil {
+		return m.ErrStream
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("cc.arduino.cli.commands.UploadProgress_Phase", UploadProgress_Phase_name, UploadProgress_Phase_value)
 	proto.RegisterType((*UploadReq)(nil), "cc.arduino.cli.commands.UploadReq")
+	proto.RegisterType((*UploadProgress)(nil), "cc.arduino.cli.commands.UploadProgress")
 	proto.RegisterType((*UploadResp)(nil), "cc.arduino.cli.commands.UploadResp")
+	proto.RegisterMapType((map[string]string)(nil), "cc.arduino.cli.commands.UploadResp.PropertiesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "cc.arduino.cli.commands.UploadResp.ToolPathsEntry")
+	proto.RegisterType((*MultiUploadReq)(nil), "cc.arduino.cli.commands.MultiUploadReq")
+	proto.RegisterType((*MultiUploadResp)(nil), "cc.arduino.cli.commands.MultiUploadResp")
+	proto.RegisterType((*BurnBootloaderReq)(nil), "cc.arduino.cli.commands.BurnBootloaderReq")
+	proto.RegisterType((*BurnBootloaderResp)(nil), "cc.arduino.cli.commands.BurnBootloaderResp")
 }
 
 func init() { proto.RegisterFile("commands/upload.proto", fileDescriptor_cd642cc079f8acdb) }
 
 var fileDescriptor_cd642cc079f8acdb = []byte{
-	// 291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x4f, 0x4b, 0xc4, 0x30,
-	0x10, 0xc5, 0xe9, 0xba, 0xee, 0x9f, 0x59, 0x4f, 0x01, 0x35, 0x08, 0xe2, 0xba, 0xa7, 0x05, 0xd9,
-	0x14, 0xf4, 0xec, 0xc5, 0x83, 0xa0, 0x27, 0xa9, 0x78, 0xf1, 0x52, 0xd2, 0x34, 0xb5, 0xc1, 0x36,
-	0xc9, 0x26, 0xe9, 0x82, 0x5f, 0xd8, 0xcf, 0x21, 0x49, 0x1a, 0x3d, 0x79, 0xca, 0x64, 0x7e, 0xef,
-	0xcd, 0x30, 0x0f, 0x4e, 0x99, 0xea, 0x7b, 0x2a, 0x6b, 0x9b, 0x0f, 0xba, 0x53, 0xb4, 0x26, 0xda,
-	0x28, 0xa7, 0xd0, 0x39, 0x63, 0x84, 0x9a, 0x7a, 0x10, 0x52, 0x11, 0xd6, 0x09, 0x92, 0x54, 0x17,
-	0x7f, 0x7a, 0x5f, 0x28, 0x19, 0xf5, 0x9b, 0xef, 0x0c, 0x96, 0x6f, 0x61, 0x40, 0xc1, 0xf7, 0xe8,
-	0x1e, 0x16, 0x42, 0x5a, 0x47, 0x25, 0xe3, 0x38, 0x5b, 0x67, 0xdb, 0xd5, 0xed, 0x35, 0xf9, 0x67,
-	0x20, 0x79, 0x1a, 0x85, 0xc5, 0xaf, 0x05, 0x21, 0x98, 0x36, 0xfb, 0x4a, 0xe2, 0xc9, 0x3a, 0xdb,
-	0x2e, 0x8b, 0x50, 0xa3, 0x2b, 0x58, 0xd9, 0x4f, 0xee, 0x58, 0x5b, 0x6a, 0xea, 0x5a, 0x7c, 0x14,
-	0x10, 0xc4, 0xd6, 0x0b, 0x75, 0xad, 0x37, 0x69, 0x65, 0x1c, 0x9e, 0x46, 0x93, 0xaf, 0x11, 0x86,
-	0xf9, 0x81, 0x9b, 0x4a, 0x59, 0x8e, 0x8f, 0xd7, 0xd9, 0x76, 0x51, 0xa4, 0x2f, 0x3a, 0x83, 0xd9,
-	0x81, 0x1b, 0xd1, 0x7c, 0xe1, 0x59, 0x00, 0xe3, 0xcf, 0xaf, 0x11, 0xbd, 0xf7, 0x96, 0x8d, 0xe8,
-	0x38, 0x9e, 0xc7, 0x35, 0xb1, 0xf5, 0x28, 0x3a, 0xbe, 0x79, 0x06, 0x48, 0x77, 0x5a, 0x8d, 0x2e,
-	0x01, 0xd4, 0xe0, 0x4a, 0xeb, 0x0c, 0xa7, 0x7d, 0x38, 0xf5, 0xa4, 0x58, 0xaa, 0xc1, 0xbd, 0x86,
-	0x86, 0xc7, 0xdc, 0x98, 0x84, 0x27, 0x11, 0x73, 0x63, 0x22, 0x7e, 0xd8, 0xbd, 0xdf, 0x7c, 0x08,
-	0xd7, 0x0e, 0x95, 0x4f, 0x23, 0x1f, 0xd3, 0x49, 0xef, 0x8e, 0x75, 0x22, 0x37, 0x9a, 0xe5, 0x29,
-	0xa9, 0x6a, 0x16, 0xa2, 0xbe, 0xfb, 0x09, 0x00, 0x00, 0xff, 0xff, 0xfc, 0xf8, 0xc5, 0x33, 0xb3,
-	0x01, 0x00, 0x00,
+	// 896 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x5f, 0x6f, 0xdb, 0x36,
+	0x10, 0x9f, 0x6c, 0xcb, 0xb1, 0xce, 0x7f, 0x4b, 0x74, 0x2b, 0xd1, 0x75, 0x9b, 0xeb, 0x62, 0xa8,
+	0x87, 0x21, 0x0e, 0x90, 0xbe, 0x0c, 0xdd, 0xfa, 0xd0, 0x64, 0xce, 0x10, 0xa0, 0x9d, 0x3d, 0xda,
+	0x6b, 0xb7, 0xbd, 0x08, 0xb2, 0xc4, 0xc4, 0x44, 0x24, 0x51, 0x21, 0xa9, 0x74, 0xfe, 0x22, 0xdb,
+	0x97, 0xda, 0xfb, 0x3e, 0xc3, 0xbe, 0xc5, 0x40, 0x52, 0x92, 0xeb, 0xa0, 0x59, 0x33, 0x0c, 0x7b,
+	0x92, 0xee, 0x77, 0xf7, 0xbb, 0x23, 0x8f, 0xbf, 0x23, 0xe1, 0xc3, 0x90, 0x27, 0x49, 0x90, 0x46,
+	0xf2, 0x20, 0xcf, 0x62, 0x1e, 0x44, 0x93, 0x4c, 0x70, 0xc5, 0xd1, 0xbd, 0x30, 0x9c, 0x04, 0x22,
+	0xca, 0x59, 0xca, 0x27, 0x61, 0xcc, 0x26, 0x65, 0xd4, 0xfd, 0x6d, 0xbc, 0xfe, 0xe1, 0xa9, 0x8d,
+	0x1f, 0xfd, 0xde, 0x00, 0xef, 0x47, 0x93, 0x80, 0xd0, 0x4b, 0xf4, 0x0c, 0x5a, 0x2c, 0x95, 0x2a,
+	0x48, 0x43, 0x8a, 0x9d, 0xa1, 0x33, 0x6e, 0x1f, 0x3e, 0x9c, 0xdc, 0x90, 0x70, 0x72, 0x5a, 0x04,
+	0x92, 0x8a, 0x82, 0x10, 0x34, 0xce, 0x2e, 0x57, 0x29, 0xae, 0x0d, 0x9d, 0xb1, 0x47, 0xcc, 0x3f,
+	0xfa, 0x0c, 0xda, 0xf2, 0x82, 0xaa, 0x70, 0xed, 0x67, 0x81, 0x5a, 0xe3, 0xba, 0x71, 0x81, 0x85,
+	0xe6, 0x81, 0x5a, 0x6b, 0x52, 0xc6, 0x85, 0xc2, 0x0d, 0x4b, 0xd2, 0xff, 0x08, 0xc3, 0xde, 0x15,
+	0x15, 0x2b, 0x2e, 0x29, 0x76, 0x87, 0xce, 0xb8, 0x45, 0x4a, 0x13, 0x7d, 0x04, 0xcd, 0x2b, 0x2a,
+	0xd8, 0xd9, 0x06, 0x37, 0x8d, 0xa3, 0xb0, 0x74, 0x19, 0x96, 0x68, 0xae, 0x7f, 0xc6, 0x62, 0x8a,
+	0xf7, 0x6c, 0x19, 0x0b, 0x9d, 0xb0, 0x98, 0xa2, 0x4f, 0x01, 0x32, 0xc1, 0xcf, 0x45, 0x90, 0x24,
+	0x54, 0xe0, 0x96, 0xf5, 0x6f, 0x11, 0xf4, 0x05, 0x0c, 0x52, 0xaa, 0xde, 0x70, 0x71, 0xe1, 0x67,
+	0x81, 0x94, 0x6f, 0xb8, 0x88, 0xb0, 0x67, 0xa2, 0xfa, 0x05, 0x3e, 0x2f, 0x60, 0xf4, 0x09, 0x14,
+	0x89, 0xfd, 0x88, 0x09, 0x0c, 0x26, 0xc8, 0xb3, 0xc8, 0xb7, 0x4c, 0xa0, 0x7b, 0xb0, 0x17, 0x89,
+	0x8d, 0x2f, 0xf2, 0x14, 0xb7, 0xed, 0x1a, 0x23, 0xb1, 0x21, 0x79, 0xaa, 0x79, 0x8a, 0x25, 0x94,
+	0xe7, 0xca, 0x4f, 0x24, 0xee, 0x0c, 0x9d, 0xb1, 0x4b, 0xbc, 0x02, 0x79, 0x29, 0xd1, 0xe7, 0xd0,
+	0xcb, 0x02, 0x21, 0xa9, 0x6f, 0x56, 0x45, 0xa5, 0xc4, 0x5d, 0x43, 0xef, 0x1a, 0x74, 0x5e, 0x80,
+	0xe8, 0x31, 0xf4, 0x05, 0x0d, 0xa2, 0x55, 0x10, 0x5e, 0xf8, 0x45, 0x2b, 0x7a, 0x26, 0xae, 0x57,
+	0xc2, 0xaf, 0x6c, 0x4b, 0x1e, 0x41, 0xb7, 0x0a, 0x34, 0x4d, 0xe9, 0x9b, 0x95, 0x76, 0x4a, 0xd0,
+	0xb4, 0x05, 0xc3, 0x5e, 0x26, 0xb8, 0x71, 0x0f, 0x8c, 0xbb, 0x34, 0x47, 0x7f, 0xd5, 0xa0, 0x67,
+	0x95, 0x51, 0x95, 0x3e, 0x06, 0x37, 0x5b, 0x07, 0xd2, 0x6a, 0xa3, 0x77, 0xb8, 0x7f, 0xa3, 0x36,
+	0x76, 0x79, 0x93, 0xb9, 0x26, 0x11, 0xcb, 0xd5, 0x15, 0x13, 0x2a, 0x65, 0x70, 0x4e, 0x0b, 0x9d,
+	0x94, 0x26, 0x7a, 0x00, 0x5e, 0xc8, 0x93, 0x2c, 0xa6, 0x8a, 0x46, 0x46, 0x28, 0x2d, 0xb2, 0x05,
+	0xde, 0xa9, 0x93, 0x07, 0xe0, 0xf1, 0x8c, 0x8a, 0x40, 0x31, 0x9e, 0x1a, 0xa5, 0x78, 0x64, 0x0b,
+	0x98, 0xbd, 0x51, 0x11, 0xd2, 0x54, 0x19, 0xb1, 0xd4, 0x48, 0x69, 0xa2, 0x8f, 0xc1, 0xa3, 0xbf,
+	0x32, 0xe5, 0x87, 0x3c, 0xb2, 0x5a, 0x71, 0x49, 0x4b, 0x03, 0xc7, 0x3c, 0xa2, 0xa3, 0x4b, 0x70,
+	0xcd, 0x82, 0x91, 0x07, 0x2e, 0x99, 0x2e, 0xa6, 0xcb, 0xc1, 0x07, 0xe8, 0x0e, 0x74, 0x5f, 0x3f,
+	0x3f, 0x5d, 0xfa, 0x27, 0x33, 0xe2, 0xcf, 0x67, 0x64, 0x39, 0x70, 0x50, 0x07, 0x5a, 0xdf, 0x4f,
+	0x5f, 0x5b, 0xab, 0x86, 0x7a, 0x00, 0xcb, 0xd9, 0xec, 0x85, 0xbf, 0x58, 0x3e, 0x27, 0xcb, 0x41,
+	0x5d, 0x13, 0x8c, 0x3d, 0x27, 0xb3, 0xef, 0xc8, 0x74, 0xb1, 0x18, 0x34, 0x50, 0x17, 0x3c, 0x03,
+	0x4d, 0x7f, 0x3a, 0x5d, 0x0e, 0x5c, 0x04, 0xd0, 0x7c, 0x35, 0x25, 0xa7, 0x27, 0x3f, 0x0f, 0x9a,
+	0xa3, 0x3f, 0xeb, 0x00, 0xe5, 0x14, 0xca, 0x4c, 0x0b, 0x45, 0x8b, 0x44, 0x2a, 0x41, 0x83, 0xc4,
+	0x34, 0xbb, 0x43, 0x3c, 0x9e, 0xab, 0x85, 0x01, 0xb4, 0x9b, 0x0a, 0x51, 0xba, 0x6b, 0xd6, 0x4d,
+	0x85, 0x28, 0xdc, 0x0f, 0xa1, 0x53, 0x1c, 0x84, 0x1f, 0xb3, 0x94, 0xe2, 0xfa, 0xb0, 0x3e, 0xf6,
+	0x48, 0xbb, 0xc0, 0x5e, 0xb0, 0x94, 0xa2, 0x1f, 0x00, 0x14, 0xe7, 0xb1, 0x19, 0x49, 0x89, 0x1b,
+	0xc3, 0xfa, 0xb8, 0x7d, 0x78, 0xf8, 0x9e, 0xd3, 0xd4, 0x2b, 0x9b, 0x2c, 0x39, 0x8f, 0xf5, 0xd4,
+	0xca, 0x69, 0xaa, 0xc4, 0x86, 0x78, 0xaa, 0xb4, 0xd1, 0xc2, 0xcc, 0x57, 0x46, 0x85, 0x62, 0x54,
+	0x62, 0xd7, 0xa4, 0x7c, 0x72, 0x9b, 0x94, 0xf3, 0x8a, 0x65, 0x73, 0xbe, 0x95, 0x06, 0x1d, 0x43,
+	0xab, 0x1a, 0x86, 0xa6, 0xb9, 0x8f, 0x1e, 0xdf, 0x52, 0x73, 0xa4, 0x22, 0xde, 0xff, 0x06, 0x7a,
+	0xbb, 0xcb, 0x46, 0x03, 0xa8, 0x5f, 0xd0, 0x8d, 0x69, 0xac, 0x47, 0xf4, 0x2f, 0xba, 0x0b, 0xee,
+	0x55, 0x10, 0xe7, 0xa5, 0x24, 0xad, 0xf1, 0xb4, 0xf6, 0x95, 0x73, 0xff, 0x19, 0xf4, 0xaf, 0xad,
+	0xf0, 0xdf, 0xd0, 0x47, 0x2b, 0xe8, 0xbd, 0xcc, 0x63, 0xc5, 0xb6, 0x77, 0xec, 0x53, 0x68, 0xda,
+	0x1b, 0xbb, 0xb8, 0x61, 0x47, 0xef, 0x6d, 0xd2, 0x25, 0x29, 0x18, 0xba, 0x8e, 0xd6, 0xbd, 0xc4,
+	0x35, 0x73, 0xa6, 0xd6, 0x18, 0xfd, 0xe6, 0x40, 0x7f, 0xa7, 0x88, 0xcc, 0xaa, 0x69, 0x71, 0xde,
+	0x9a, 0x96, 0xaf, 0xab, 0xca, 0x35, 0x53, 0xf9, 0xd1, 0x2d, 0x8e, 0xa7, 0x2a, 0xfd, 0xcf, 0xc3,
+	0x79, 0x17, 0x5c, 0x2a, 0x04, 0x17, 0xc5, 0x74, 0x5a, 0x63, 0xf4, 0x87, 0x03, 0x77, 0x8e, 0x72,
+	0x91, 0x1e, 0x71, 0xae, 0x74, 0x12, 0x2a, 0xfe, 0xa7, 0x47, 0xa6, 0xdc, 0x6d, 0xfd, 0xdd, 0x6f,
+	0x48, 0xe3, 0xa6, 0x37, 0xc4, 0xdd, 0x79, 0x43, 0x76, 0x9f, 0x88, 0xe6, 0xf5, 0x27, 0x62, 0x44,
+	0x00, 0x5d, 0xdf, 0xcd, 0x7f, 0x1d, 0xd6, 0xa3, 0xfd, 0x5f, 0xbe, 0x3c, 0x67, 0x6a, 0x9d, 0xaf,
+	0xf4, 0x9e, 0x0f, 0x8a, 0x1e, 0x94, 0xdf, 0xfd, 0x30, 0x66, 0x07, 0x22, 0x0b, 0x0f, 0xca, 0x7e,
+	0xac, 0x9a, 0xe6, 0xd5, 0x7e, 0xf2, 0xf7, 0x00, 0x2d, 0x7d, 0x96, 0x83, 0xfe, 0x07, 0x00, 0x00,
 }
@@ -29,14 +29,28 @@ type MonitorConfig_TargetType int32
 
 const (
 	MonitorConfig_SERIAL MonitorConfig_TargetType = 0
+	// A TCP socket, the target is in the form HOST:PORT.
+	MonitorConfig_TCP MonitorConfig_TargetType = 1
+	// A monitor that echoes back the data sent to it, useful for testing.
+	MonitorConfig_LOOPBACK MonitorConfig_TargetType = 2
+	// Replays a session recorded with `record_file`, the target is the path
+	// of the capture relative to the monitor records directory of the
+	// daemon. The `Speed` setting accelerates the replay.
+	MonitorConfig_REPLAY MonitorConfig_TargetType = 3
 )
 
 var MonitorConfig_TargetType_name = map[int32]string{
 	0: "SERIAL",
+	1: "TCP",
+	2: "LOOPBACK",
+	3: "REPLAY",
 }
 
 var MonitorConfig_TargetType_value = map[string]int32{
-	"SERIAL": 0,
+	"SERIAL":   0,
+	"TCP":      1,
+	"LOOPBACK": 2,
+	"REPLAY":   3,
 }
 
 func (x MonitorConfig_TargetType) String() string {
@@ -44,20 +58,22 @@ func (x MonitorConfig_TargetType) String() string {
 }
 
 func (MonitorConfig_TargetType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_94d5950496a7550d, []int{1, 0}
+	return fileDescriptor_94d5950496a7550d, []int{2, 0}
 }
 
 // The top-level message sent by the client for the `StreamingOpen` method.
 // Multiple `StreamingOpenReq` messages can be sent but the first message
 // must contain a `monitor_config` message to initialize the monitor target.
 // All subsequent messages must contain bytes to be sent to the target
-// and must not contain a `monitor_config` message.
+// or a `control` message, and must not contain a `monitor_config` message.
 type StreamingOpenReq struct {
 	// Content must be either a monitor config or data to be sent.
 	//
 	// Types that are valid to be assigned to Content:
 	//	*StreamingOpenReq_MonitorConfig
 	//	*StreamingOpenReq_Data
+	//	*StreamingOpenReq_Control
+	//	*StreamingOpenReq_RecvAcknowledge
 	Content              isStreamingOpenReq_Content `protobuf_oneof:"content"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
@@ -101,10 +117,22 @@ type StreamingOpenReq_Data struct {
 	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
 }
 
+type StreamingOpenReq_Control struct {
+	Control *MonitorControl `protobuf:"bytes,3,opt,name=control,proto3,oneof"`
+}
+
+type StreamingOpenReq_RecvAcknowledge struct {
+	RecvAcknowledge int32 `protobuf:"varint,4,opt,name=recv_acknowledge,json=recvAcknowledge,proto3,oneof"`
+}
+
 func (*StreamingOpenReq_MonitorConfig) isStreamingOpenReq_Content() {}
 
 func (*StreamingOpenReq_Data) isStreamingOpenReq_Content() {}
 
+func (*StreamingOpenReq_Control) isStreamingOpenReq_Content() {}
+
+func (*StreamingOpenReq_RecvAcknowledge) isStreamingOpenReq_Content() {}
+
 func (m *StreamingOpenReq) GetContent() isStreamingOpenReq_Content {
 	if m != nil {
 		return m.Content
@@ -126,30 +154,110 @@ func (m *StreamingOpenReq) GetData() []byte {
 	return nil
 }
 
+func (m *StreamingOpenReq) GetControl() *MonitorControl {
+	if x, ok := m.GetContent().(*StreamingOpenReq_Control); ok {
+		return x.Control
+	}
+	return nil
+}
+
+func (m *StreamingOpenReq) GetRecvAcknowledge() int32 {
+	if x, ok := m.GetContent().(*StreamingOpenReq_RecvAcknowledge); ok {
+		return x.RecvAcknowledge
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*StreamingOpenReq) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*StreamingOpenReq_MonitorConfig)(nil),
 		(*StreamingOpenReq_Data)(nil),
+		(*StreamingOpenReq_Control)(nil),
+		(*StreamingOpenReq_RecvAcknowledge)(nil),
+	}
+}
+
+// Changes the settings of an open monitor target, for example the baud rate
+// or the state of the DTR and RTS lines of a serial port.
+type MonitorControl struct {
+	// The settings to change, with the same keys used in the
+	// `additionalConfig` of `MonitorConfig`. Missing settings are left
+	// unchanged.
+	Settings             *_struct.Struct `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MonitorControl) Reset()         { *m = MonitorControl{} }
+func (m *MonitorControl) String() string { return proto.CompactTextString(m) }
+func (*MonitorControl) ProtoMessage()    {}
+func (*MonitorControl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94d5950496a7550d, []int{1}
+}
+
+func (m *MonitorControl) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MonitorControl.Unmarshal(m, b)
+}
+func (m *MonitorControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MonitorControl.Marshal(b, m, deterministic)
+}
+func (m *MonitorControl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitorControl.Merge(m, src)
+}
+func (m *MonitorControl) XXX_Size() int {
+	return xxx_messageInfo_MonitorControl.Size(m)
+}
+func (m *MonitorControl) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitorControl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitorControl proto.InternalMessageInfo
+
+func (m *MonitorControl) GetSettings() *_struct.Struct {
+	if m != nil {
+		return m.Settings
 	}
+	return nil
 }
 
 // Tells the monitor which target to open and provides additional parameters
 // that might be needed to configure the target or the monitor itself.
 type MonitorConfig struct {
-	Target               string                   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	Type                 MonitorConfig_TargetType `protobuf:"varint,2,opt,name=type,proto3,enum=cc.arduino.cli.monitor.MonitorConfig_TargetType" json:"type,omitempty"`
-	AdditionalConfig     *_struct.Struct          `protobuf:"bytes,3,opt,name=additionalConfig,proto3" json:"additionalConfig,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	Target string                   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Type   MonitorConfig_TargetType `protobuf:"varint,2,opt,name=type,proto3,enum=cc.arduino.cli.monitor.MonitorConfig_TargetType" json:"type,omitempty"`
+	// Settings of the target. For serial ports: `BaudRate`, `DataBits` (5 to 8),
+	// `Parity` (none, even, odd, mark, space), `StopBits` (1, 1.5, 2) and the
+	// initial state of the `DTR` and `RTS` lines.
+	AdditionalConfig *_struct.Struct `protobuf:"bytes,3,opt,name=additionalConfig,proto3" json:"additionalConfig,omitempty"`
+	// If set, the session is recorded in this file with the timestamp and
+	// the direction of each chunk of data. The path is relative to the
+	// monitor records directory of the daemon (`monitor-records` in the data
+	// directory), absolute paths and paths outside of it are rejected.
+	RecordFile string `protobuf:"bytes,4,opt,name=record_file,json=recordFile,proto3" json:"record_file,omitempty"`
+	// Controls how the data received from the target is sent to the client.
+	Buffer *MonitorBufferConfig `protobuf:"bytes,5,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	// Opens the target in shared mode: if the same target is already open in
+	// shared mode, the client is attached to it instead of opening it again.
+	// The data received from the target is sent to all the attached clients
+	// and the data sent by the clients is merged. The settings and the
+	// `record_file` of the client that opened the target are used: the
+	// clients that request different ones are rejected.
+	Shared bool `protobuf:"varint,6,opt,name=shared,proto3" json:"shared,omitempty"`
+	// Reserves the writing to the target to this client, the data and the
+	// settings changes sent by the other attached clients are rejected.
+	ExclusiveWriter      bool     `protobuf:"varint,7,opt,name=exclusive_writer,json=exclusiveWriter,proto3" json:"exclusive_writer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MonitorConfig) Reset()         { *m = MonitorConfig{} }
 func (m *MonitorConfig) String() string { return proto.CompactTextString(m) }
 func (*MonitorConfig) ProtoMessage()    {}
 func (*MonitorConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94d5950496a7550d, []int{1}
+	return fileDescriptor_94d5950496a7550d, []int{2}
 }
 
 func (m *MonitorConfig) XXX_Unmarshal(b []byte) error {
@@ -191,19 +299,122 @@ func (m *MonitorConfig) GetAdditionalConfig() *_struct.Struct {
 	return nil
 }
 
-//
-type StreamingOpenResp struct {
-	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+func (m *MonitorConfig) GetRecordFile() string {
+	if m != nil {
+		return m.RecordFile
+	}
+	return ""
+}
+
+func (m *MonitorConfig) GetBuffer() *MonitorBufferConfig {
+	if m != nil {
+		return m.Buffer
+	}
+	return nil
+}
+
+func (m *MonitorConfig) GetShared() bool {
+	if m != nil {
+		return m.Shared
+	}
+	return false
+}
+
+func (m *MonitorConfig) GetExclusiveWriter() bool {
+	if m != nil {
+		return m.ExclusiveWriter
+	}
+	return false
+}
+
+// Configures the buffering of the data received from the target. The data
+// is sent in chunks of up to `max_chunk_size` bytes, a smaller chunk is
+// sent if the data has been waiting for more than `max_latency_ms`.
+type MonitorBufferConfig struct {
+	// Maximum size of a data chunk, defaults to 1024 bytes.
+	MaxChunkSize int32 `protobuf:"varint,1,opt,name=max_chunk_size,json=maxChunkSize,proto3" json:"max_chunk_size,omitempty"`
+	// Maximum time the received data waits before being sent, defaults to
+	// 16ms.
+	MaxLatencyMs int32 `protobuf:"varint,2,opt,name=max_latency_ms,json=maxLatencyMs,proto3" json:"max_latency_ms,omitempty"`
+	// Maximum amount of data waiting to be sent, the data received when the
+	// buffer is full is dropped. Defaults to 64KiB.
+	BufferSize int32 `protobuf:"varint,3,opt,name=buffer_size,json=bufferSize,proto3" json:"buffer_size,omitempty"`
+	// If greater than zero enables the flow control: the monitor sends at
+	// most `window_size` bytes and then waits for the client to acknowledge
+	// them with `recv_acknowledge`.
+	WindowSize           int32    `protobuf:"varint,4,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *MonitorBufferConfig) Reset()         { *m = MonitorBufferConfig{} }
+func (m *MonitorBufferConfig) String() string { return proto.CompactTextString(m) }
+func (*MonitorBufferConfig) ProtoMessage()    {}
+func (*MonitorBufferConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94d5950496a7550d, []int{3}
+}
+
+func (m *MonitorBufferConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MonitorBufferConfig.Unmarshal(m, b)
+}
+func (m *MonitorBufferConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MonitorBufferConfig.Marshal(b, m, deterministic)
+}
+func (m *MonitorBufferConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitorBufferConfig.Merge(m, src)
+}
+func (m *MonitorBufferConfig) XXX_Size() int {
+	return xxx_messageInfo_MonitorBufferConfig.Size(m)
+}
+func (m *MonitorBufferConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitorBufferConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitorBufferConfig proto.InternalMessageInfo
+
+func (m *MonitorBufferConfig) GetMaxChunkSize() int32 {
+	if m != nil {
+		return m.MaxChunkSize
+	}
+	return 0
+}
+
+func (m *MonitorBufferConfig) GetMaxLatencyMs() int32 {
+	if m != nil {
+		return m.MaxLatencyMs
+	}
+	return 0
+}
+
+func (m *MonitorBufferConfig) GetBufferSize() int32 {
+	if m != nil {
+		return m.BufferSize
+	}
+	return 0
+}
+
+func (m *MonitorBufferConfig) GetWindowSize() int32 {
+	if m != nil {
+		return m.WindowSize
+	}
+	return 0
+}
+
+type StreamingOpenResp struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Statistics of the monitor session.
+	Stats                *MonitorStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
 func (m *StreamingOpenResp) Reset()         { *m = StreamingOpenResp{} }
 func (m *StreamingOpenResp) String() string { return proto.CompactTextString(m) }
 func (*StreamingOpenResp) ProtoMessage()    {}
 func (*StreamingOpenResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94d5950496a7550d, []int{2}
+	return fileDescriptor_94d5950496a7550d, []int{4}
 }
 
 func (m *StreamingOpenResp) XXX_Unmarshal(b []byte) error {
@@ -231,39 +442,292 @@ func (m *StreamingOpenResp) GetData() []byte {
 	return nil
 }
 
+func (m *StreamingOpenResp) GetStats() *MonitorStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type MonitorStats struct {
+	// Bytes received from the target.
+	RxBytes uint64 `protobuf:"varint,1,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`
+	// Bytes sent to the target.
+	TxBytes uint64 `protobuf:"varint,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// Bytes received from the target and dropped because the buffer was full.
+	DroppedBytes         uint64   `protobuf:"varint,3,opt,name=dropped_bytes,json=droppedBytes,proto3" json:"dropped_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MonitorStats) Reset()         { *m = MonitorStats{} }
+func (m *MonitorStats) String() string { return proto.CompactTextString(m) }
+func (*MonitorStats) ProtoMessage()    {}
+func (*MonitorStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94d5950496a7550d, []int{5}
+}
+
+func (m *MonitorStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MonitorStats.Unmarshal(m, b)
+}
+func (m *MonitorStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MonitorStats.Marshal(b, m, deterministic)
+}
+func (m *MonitorStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitorStats.Merge(m, src)
+}
+func (m *MonitorStats) XXX_Size() int {
+	return xxx_messageInfo_MonitorStats.Size(m)
+}
+func (m *MonitorStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitorStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitorStats proto.InternalMessageInfo
+
+func (m *MonitorStats) GetRxBytes() uint64 {
+	if m != nil {
+		return m.RxBytes
+	}
+	return 0
+}
+
+func (m *MonitorStats) GetTxBytes() uint64 {
+	if m != nil {
+		return m.TxBytes
+	}
+	return 0
+}
+
+func (m *MonitorStats) GetDroppedBytes() uint64 {
+	if m != nil {
+		return m.DroppedBytes
+	}
+	return 0
+}
+
+type MonitorPortSettingsReq struct {
+	Type                 MonitorConfig_TargetType `protobuf:"varint,1,opt,name=type,proto3,enum=cc.arduino.cli.monitor.MonitorConfig_TargetType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *MonitorPortSettingsReq) Reset()         { *m = MonitorPortSettingsReq{} }
+func (m *MonitorPortSettingsReq) String() string { return proto.CompactTextString(m) }
+func (*MonitorPortSettingsReq) ProtoMessage()    {}
+func (*MonitorPortSettingsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94d5950496a7550d, []int{6}
+}
+
+func (m *MonitorPortSettingsReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MonitorPortSettingsReq.Unmarshal(m, b)
+}
+func (m *MonitorPortSettingsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MonitorPortSettingsReq.Marshal(b, m, deterministic)
+}
+func (m *MonitorPortSettingsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitorPortSettingsReq.Merge(m, src)
+}
+func (m *MonitorPortSettingsReq) XXX_Size() int {
+	return xxx_messageInfo_MonitorPortSettingsReq.Size(m)
+}
+func (m *MonitorPortSettingsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitorPortSettingsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitorPortSettingsReq proto.InternalMessageInfo
+
+func (m *MonitorPortSettingsReq) GetType() MonitorConfig_TargetType {
+	if m != nil {
+		return m.Type
+	}
+	return MonitorConfig_SERIAL
+}
+
+type MonitorPortSettingsResp struct {
+	Settings             []*MonitorPortSetting `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *MonitorPortSettingsResp) Reset()         { *m = MonitorPortSettingsResp{} }
+func (m *MonitorPortSettingsResp) String() string { return proto.CompactTextString(m) }
+func (*MonitorPortSettingsResp) ProtoMessage()    {}
+func (*MonitorPortSettingsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94d5950496a7550d, []int{7}
+}
+
+func (m *MonitorPortSettingsResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MonitorPortSettingsResp.Unmarshal(m, b)
+}
+func (m *MonitorPortSettingsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MonitorPortSettingsResp.Marshal(b, m, deterministic)
+}
+func (m *MonitorPortSettingsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitorPortSettingsResp.Merge(m, src)
+}
+func (m *MonitorPortSettingsResp) XXX_Size() int {
+	return xxx_messageInfo_MonitorPortSettingsResp.Size(m)
+}
+func (m *MonitorPortSettingsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitorPortSettingsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitorPortSettingsResp proto.InternalMessageInfo
+
+func (m *MonitorPortSettingsResp) GetSettings() []*MonitorPortSetting {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+// Describes a setting of a monitor target.
+type MonitorPortSetting struct {
+	// The key of the setting in `additionalConfig`.
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// The type of the value: "enum", "bool", "int", "float" or "string".
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// The allowed values, for "enum" settings.
+	Values               []string `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`
+	DefaultValue         string   `protobuf:"bytes,5,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MonitorPortSetting) Reset()         { *m = MonitorPortSetting{} }
+func (m *MonitorPortSetting) String() string { return proto.CompactTextString(m) }
+func (*MonitorPortSetting) ProtoMessage()    {}
+func (*MonitorPortSetting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94d5950496a7550d, []int{8}
+}
+
+func (m *MonitorPortSetting) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MonitorPortSetting.Unmarshal(m, b)
+}
+func (m *MonitorPortSetting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MonitorPortSetting.Marshal(b, m, deterministic)
+}
+func (m *MonitorPortSetting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitorPortSetting.Merge(m, src)
+}
+func (m *MonitorPortSetting) XXX_Size() int {
+	return xxx_messageInfo_MonitorPortSetting.Size(m)
+}
+func (m *MonitorPortSetting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitorPortSetting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitorPortSetting proto.InternalMessageInfo
+
+func (m *MonitorPortSetting) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *MonitorPortSetting) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *MonitorPortSetting) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *MonitorPortSetting) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *MonitorPortSetting) GetDefaultValue() string {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cc.arduino.cli.monitor.MonitorConfig_TargetType", MonitorConfig_TargetType_name, MonitorConfig_TargetType_value)
 	proto.RegisterType((*StreamingOpenReq)(nil), "cc.arduino.cli.monitor.StreamingOpenReq")
+	proto.RegisterType((*MonitorControl)(nil), "cc.arduino.cli.monitor.MonitorControl")
 	proto.RegisterType((*MonitorConfig)(nil), "cc.arduino.cli.monitor.MonitorConfig")
+	proto.RegisterType((*MonitorBufferConfig)(nil), "cc.arduino.cli.monitor.MonitorBufferConfig")
 	proto.RegisterType((*StreamingOpenResp)(nil), "cc.arduino.cli.monitor.StreamingOpenResp")
+	proto.RegisterType((*MonitorStats)(nil), "cc.arduino.cli.monitor.MonitorStats")
+	proto.RegisterType((*MonitorPortSettingsReq)(nil), "cc.arduino.cli.monitor.MonitorPortSettingsReq")
+	proto.RegisterType((*MonitorPortSettingsResp)(nil), "cc.arduino.cli.monitor.MonitorPortSettingsResp")
+	proto.RegisterType((*MonitorPortSetting)(nil), "cc.arduino.cli.monitor.MonitorPortSetting")
 }
 
 func init() { proto.RegisterFile("monitor/monitor.proto", fileDescriptor_94d5950496a7550d) }
 
 var fileDescriptor_94d5950496a7550d = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xd1, 0x4e, 0xf2, 0x30,
-	0x14, 0xc7, 0xb7, 0xef, 0x23, 0x10, 0x8e, 0x60, 0xb0, 0x51, 0x24, 0xc4, 0x0b, 0xb2, 0xc4, 0x38,
-	0x8d, 0x76, 0x04, 0x9f, 0x40, 0xd0, 0x04, 0x13, 0x89, 0x49, 0xe1, 0xca, 0xbb, 0xd2, 0x95, 0x59,
-	0x33, 0xda, 0x5a, 0xba, 0x0b, 0x6e, 0x7d, 0x3e, 0x1f, 0xca, 0x58, 0x4a, 0x14, 0xd4, 0x84, 0xab,
-	0xa6, 0xd9, 0xf9, 0x9d, 0x73, 0xfe, 0xbf, 0x15, 0x8e, 0xe6, 0x4a, 0x0a, 0xab, 0x4c, 0xe2, 0x4f,
-	0xac, 0x8d, 0xb2, 0x0a, 0x35, 0x19, 0xc3, 0xd4, 0xa4, 0x85, 0x90, 0x0a, 0xb3, 0x5c, 0x60, 0xff,
-	0xb5, 0x7d, 0x92, 0x29, 0x95, 0xe5, 0x3c, 0x71, 0x55, 0xd3, 0x62, 0x96, 0x2c, 0xac, 0x29, 0x98,
-	0x5d, 0x51, 0xd1, 0x5b, 0x08, 0x8d, 0xb1, 0x35, 0x9c, 0xce, 0x85, 0xcc, 0x1e, 0x35, 0x97, 0x84,
-	0xbf, 0xa2, 0x11, 0xd4, 0x3d, 0x3d, 0x50, 0x72, 0x26, 0xb2, 0x56, 0xd8, 0x09, 0xe3, 0xbd, 0xde,
-	0x29, 0xfe, 0x7d, 0x04, 0x1e, 0x7d, 0x2f, 0x1e, 0x06, 0x64, 0x93, 0x46, 0x87, 0x50, 0x4a, 0xa9,
-	0xa5, 0xad, 0x7f, 0x9d, 0x30, 0xae, 0x0d, 0x03, 0xe2, 0x6e, 0xfd, 0x2a, 0x54, 0x98, 0x92, 0x96,
-	0x4b, 0x1b, 0xbd, 0x87, 0x50, 0xdf, 0xe8, 0x81, 0x9a, 0x50, 0xb6, 0xd4, 0x64, 0xdc, 0xba, 0xd1,
-	0x55, 0xe2, 0x6f, 0xe8, 0x16, 0x4a, 0x76, 0xa9, 0xb9, 0x6b, 0xb5, 0xdf, 0xeb, 0xee, 0xb4, 0x10,
-	0x9e, 0x38, 0x76, 0xb2, 0xd4, 0x9c, 0x38, 0x1a, 0x0d, 0xa0, 0x41, 0xd3, 0x54, 0x58, 0xa1, 0x24,
-	0xcd, 0x7d, 0xc4, 0xff, 0x2e, 0xe2, 0x31, 0x5e, 0xd9, 0xc2, 0x6b, 0x5b, 0x78, 0xec, 0x6c, 0x91,
-	0x1f, 0x40, 0xd4, 0x02, 0xf8, 0x6a, 0x8c, 0x00, 0xca, 0xe3, 0x3b, 0x72, 0x7f, 0xf3, 0xd0, 0x08,
-	0xa2, 0x33, 0x38, 0xd8, 0x52, 0xba, 0xd0, 0x08, 0x79, 0x09, 0x9f, 0x79, 0x6a, 0x2b, 0x05, 0xbd,
-	0x02, 0x2a, 0x7e, 0x53, 0xf4, 0x02, 0xf5, 0x0d, 0x06, 0xc5, 0x7f, 0x65, 0xdb, 0xfe, 0x5b, 0xed,
-	0xf3, 0x1d, 0x2b, 0x17, 0x3a, 0x0a, 0xe2, 0xb0, 0x1b, 0xf6, 0x2f, 0x9f, 0x2e, 0x32, 0x61, 0x9f,
-	0x8b, 0x29, 0x66, 0x6a, 0x9e, 0x78, 0x72, 0x7d, 0x5e, 0xb1, 0x5c, 0x24, 0x46, 0xb3, 0xf5, 0xeb,
-	0x9a, 0x96, 0x9d, 0x8a, 0xeb, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x6c, 0x5e, 0x00, 0x77,
-	0x02, 0x00, 0x00,
+	// 801 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xdd, 0x72, 0xdb, 0x44,
+	0x14, 0xb6, 0x2c, 0xff, 0xc4, 0xc7, 0x4e, 0x2a, 0xb6, 0x25, 0x15, 0x19, 0x66, 0xea, 0x11, 0x85,
+	0x71, 0x5b, 0x90, 0x3b, 0xee, 0x1d, 0x5c, 0xc5, 0x26, 0x9d, 0x30, 0x24, 0x93, 0xcc, 0x3a, 0x03,
+	0x03, 0x17, 0x68, 0xd6, 0xd2, 0x5a, 0x59, 0x2a, 0x6b, 0xc5, 0x6a, 0x95, 0x38, 0x7d, 0x05, 0x5e,
+	0x80, 0x7b, 0x1e, 0x90, 0x0b, 0x5e, 0x80, 0xd9, 0x1f, 0xa7, 0x71, 0x13, 0x70, 0x98, 0x5e, 0x39,
+	0xe7, 0x3b, 0xdf, 0xf9, 0xd9, 0x6f, 0xf5, 0x6d, 0xe0, 0xe3, 0x05, 0xcf, 0x99, 0xe4, 0x62, 0x68,
+	0x7f, 0xc3, 0x42, 0x70, 0xc9, 0xd1, 0x6e, 0x1c, 0x87, 0x44, 0x24, 0x15, 0xcb, 0x79, 0x18, 0x67,
+	0x2c, 0xb4, 0xd9, 0xbd, 0x4f, 0x53, 0xce, 0xd3, 0x8c, 0x0e, 0x35, 0x6b, 0x56, 0xcd, 0x87, 0xa5,
+	0x14, 0x55, 0x2c, 0x4d, 0x55, 0xf0, 0xb7, 0x03, 0xde, 0x54, 0x0a, 0x4a, 0x16, 0x2c, 0x4f, 0x4f,
+	0x0a, 0x9a, 0x63, 0xfa, 0x1b, 0x3a, 0x86, 0x6d, 0x5b, 0x3d, 0xe1, 0xf9, 0x9c, 0xa5, 0xbe, 0xd3,
+	0x77, 0x06, 0xdd, 0xd1, 0xe7, 0xe1, 0xdd, 0x23, 0xc2, 0xe3, 0x9b, 0xe4, 0xc3, 0x1a, 0x5e, 0xaf,
+	0x46, 0x8f, 0xa0, 0x91, 0x10, 0x49, 0xfc, 0x7a, 0xdf, 0x19, 0xf4, 0x0e, 0x6b, 0x58, 0x47, 0x68,
+	0x0c, 0xed, 0x98, 0xe7, 0x52, 0xf0, 0xcc, 0x77, 0x75, 0xfb, 0x2f, 0x36, 0xb7, 0x57, 0xec, 0xc3,
+	0x1a, 0x5e, 0x15, 0xa2, 0x17, 0xe0, 0x09, 0x1a, 0x5f, 0x44, 0x24, 0x7e, 0x93, 0xf3, 0xcb, 0x8c,
+	0x26, 0x29, 0xf5, 0x1b, 0x7d, 0x67, 0xd0, 0x3c, 0xac, 0xe1, 0x07, 0x2a, 0xb3, 0xff, 0x2e, 0x31,
+	0xee, 0x98, 0x81, 0x34, 0x97, 0xc1, 0x01, 0xec, 0xac, 0x37, 0x45, 0xaf, 0x60, 0xab, 0xa4, 0x52,
+	0xb2, 0x3c, 0x2d, 0xed, 0x69, 0x1f, 0x87, 0x46, 0xb8, 0x70, 0x25, 0x5c, 0x38, 0xd5, 0xc2, 0xe1,
+	0x6b, 0x62, 0xf0, 0x87, 0x0b, 0xdb, 0x6b, 0x67, 0x47, 0xbb, 0xd0, 0x92, 0x44, 0xa4, 0x54, 0xea,
+	0x26, 0x1d, 0x6c, 0x23, 0xf4, 0x2d, 0x34, 0xe4, 0x55, 0x41, 0xb5, 0x04, 0x3b, 0xa3, 0x97, 0xf7,
+	0x12, 0x32, 0x3c, 0xd3, 0xb5, 0x67, 0x57, 0x05, 0xc5, 0xba, 0x1a, 0x4d, 0xc0, 0x23, 0x49, 0xc2,
+	0x24, 0xe3, 0x39, 0xc9, 0xec, 0xd5, 0xb8, 0xff, 0xbd, 0xec, 0xad, 0x02, 0xf4, 0x04, 0xba, 0x82,
+	0xc6, 0x5c, 0x24, 0xd1, 0x9c, 0x65, 0x46, 0xae, 0x0e, 0x06, 0x03, 0xbd, 0x66, 0x99, 0x9a, 0xd2,
+	0x9a, 0x55, 0xf3, 0x39, 0x15, 0x7e, 0x53, 0xf7, 0x7e, 0xb1, 0x61, 0xdb, 0xb1, 0x26, 0x9b, 0xee,
+	0xd8, 0x96, 0x2a, 0x21, 0xca, 0x73, 0x22, 0x68, 0xe2, 0xb7, 0xfa, 0xce, 0x60, 0x0b, 0xdb, 0x08,
+	0x3d, 0x03, 0x8f, 0x2e, 0xe3, 0xac, 0x2a, 0xd9, 0x05, 0x8d, 0x2e, 0x05, 0x93, 0x54, 0xf8, 0x6d,
+	0xcd, 0x78, 0x70, 0x8d, 0xff, 0xa8, 0xe1, 0xe0, 0x1b, 0x80, 0x77, 0x0a, 0x20, 0x80, 0xd6, 0xf4,
+	0x00, 0x7f, 0xb7, 0x7f, 0xe4, 0xd5, 0x50, 0x1b, 0xdc, 0xb3, 0xc9, 0xa9, 0xe7, 0xa0, 0x1e, 0x6c,
+	0x1d, 0x9d, 0x9c, 0x9c, 0x8e, 0xf7, 0x27, 0xdf, 0x7b, 0x75, 0x45, 0xc1, 0x07, 0xa7, 0x47, 0xfb,
+	0x3f, 0x79, 0x6e, 0xf0, 0xa7, 0x03, 0x0f, 0xef, 0xd8, 0x0f, 0x3d, 0x85, 0x9d, 0x05, 0x59, 0x46,
+	0xf1, 0x79, 0x95, 0xbf, 0x89, 0x4a, 0xf6, 0x96, 0xea, 0x8b, 0x6a, 0xe2, 0xde, 0x82, 0x2c, 0x27,
+	0x0a, 0x9c, 0xb2, 0xb7, 0x74, 0xc5, 0xca, 0x88, 0xa4, 0x79, 0x7c, 0x15, 0x2d, 0x4a, 0xbf, 0x7e,
+	0xcd, 0x3a, 0x32, 0xe0, 0x71, 0xa9, 0x94, 0x34, 0xa7, 0x35, 0x8d, 0x5c, 0x4d, 0x01, 0x03, 0xe9,
+	0x36, 0x4f, 0xa0, 0x7b, 0xc9, 0xf2, 0x84, 0x5f, 0x1a, 0x42, 0xc3, 0x10, 0x0c, 0xa4, 0x08, 0x41,
+	0x0c, 0x1f, 0xbd, 0x67, 0xbe, 0xb2, 0x40, 0xc8, 0xda, 0x45, 0x2d, 0xd6, 0xb3, 0x66, 0xf9, 0x1a,
+	0x9a, 0xa5, 0x24, 0xd2, 0xec, 0xd1, 0x1d, 0x3d, 0xdd, 0x70, 0x25, 0x53, 0xc5, 0xc5, 0xa6, 0x24,
+	0x60, 0xd0, 0xbb, 0x09, 0xa3, 0x4f, 0x60, 0x4b, 0x2c, 0xa3, 0xd9, 0x95, 0xa4, 0xe6, 0x53, 0x6f,
+	0xe0, 0xb6, 0x58, 0x8e, 0x55, 0xa8, 0x52, 0x72, 0x95, 0xaa, 0x9b, 0x94, 0xb4, 0xa9, 0xcf, 0x60,
+	0x3b, 0x11, 0xbc, 0x28, 0x68, 0x62, 0xf3, 0xae, 0xce, 0xf7, 0x2c, 0xa8, 0x49, 0xc1, 0x2f, 0xb0,
+	0x6b, 0x47, 0x9d, 0x72, 0x21, 0xa7, 0xd6, 0x27, 0xea, 0x49, 0x59, 0x19, 0xc0, 0xf9, 0x10, 0x03,
+	0x04, 0x04, 0x1e, 0xdf, 0xd9, 0xbf, 0x2c, 0xd0, 0xeb, 0x35, 0x03, 0xbb, 0x83, 0xee, 0xe8, 0xf9,
+	0x86, 0x21, 0x37, 0x5a, 0xdc, 0xf0, 0xf4, 0xef, 0x0e, 0xa0, 0xdb, 0x04, 0xb4, 0x03, 0x75, 0x96,
+	0x58, 0x53, 0xd7, 0x59, 0x82, 0x1e, 0x41, 0x33, 0x23, 0x33, 0x9a, 0x69, 0x99, 0x3a, 0xd8, 0x04,
+	0xea, 0xea, 0xf4, 0x29, 0x5d, 0x0d, 0xea, 0xbf, 0x95, 0x13, 0x2e, 0x48, 0x56, 0xd1, 0xd2, 0x6f,
+	0xf4, 0x5d, 0xf5, 0x24, 0x98, 0x48, 0x0b, 0x4a, 0xe7, 0xa4, 0xca, 0x64, 0xa4, 0x11, 0xed, 0xb6,
+	0x0e, 0xee, 0x59, 0xf0, 0x07, 0x85, 0x8d, 0xfe, 0x72, 0xa0, 0x6d, 0xb7, 0x41, 0xbf, 0xc2, 0xf6,
+	0xda, 0xc7, 0x82, 0x06, 0xff, 0x76, 0xc0, 0xf7, 0x1f, 0xf4, 0xbd, 0x67, 0xf7, 0x64, 0x96, 0x45,
+	0x50, 0x1b, 0x38, 0x2f, 0x1d, 0xb4, 0x84, 0x87, 0xb7, 0x45, 0x28, 0x51, 0x78, 0x7f, 0x49, 0xd5,
+	0xad, 0xef, 0x0d, 0xff, 0x17, 0x5f, 0x4d, 0x1f, 0x7f, 0xf9, 0xf3, 0xf3, 0x94, 0xc9, 0xf3, 0x6a,
+	0x16, 0xc6, 0x7c, 0x31, 0xb4, 0xb5, 0xab, 0xdf, 0xaf, 0xe2, 0x8c, 0x0d, 0x45, 0x11, 0xaf, 0xfe,
+	0xf5, 0xcd, 0x5a, 0xfa, 0xbd, 0x7b, 0xf5, 0xcf, 0x00, 0x59, 0xf4, 0x1b, 0x4d, 0x14, 0x07, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -279,6 +743,8 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MonitorClient interface {
 	StreamingOpen(ctx context.Context, opts ...grpc.CallOption) (Monitor_StreamingOpenClient, error)
+	// Returns the settings supported by a type of monitor target.
+	MonitorPortSettings(ctx context.Context, in *MonitorPortSettingsReq, opts ...grpc.CallOption) (*MonitorPortSettingsResp, error)
 }
 
 type monitorClient struct {
@@ -320,9 +786,20 @@ func (x *monitorStreamingOpenClient) Recv() (*StreamingOpenResp, error) {
 	return m, nil
 }
 
+func (c *monitorClient) MonitorPortSettings(ctx context.Context, in *MonitorPortSettingsReq, opts ...grpc.CallOption) (*MonitorPortSettingsResp, error) {
+	out := new(MonitorPortSettingsResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.monitor.Monitor/MonitorPortSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MonitorServer is the server API for Monitor service.
 type MonitorServer interface {
 	StreamingOpen(Monitor_StreamingOpenServer) error
+	// Returns the settings supported by a type of monitor target.
+	MonitorPortSettings(context.Context, *MonitorPortSettingsReq) (*MonitorPortSettingsResp, error)
 }
 
 // UnimplementedMonitorServer can be embedded to have forward compatible implementations.
@@ -332,6 +809,9 @@ type UnimplementedMonitorServer struct {
 func (*UnimplementedMonitorServer) StreamingOpen(srv Monitor_StreamingOpenServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamingOpen not implemented")
 }
+func (*UnimplementedMonitorServer) MonitorPortSettings(ctx context.Context, req *MonitorPortSettingsReq) (*MonitorPortSettingsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MonitorPortSettings not implemented")
+}
 
 func RegisterMonitorServer(s *grpc.Server, srv MonitorServer) {
 	s.RegisterService(&_Monitor_serviceDesc, srv)
@@ -363,10 +843,33 @@ func (x *monitorStreamingOpenServer) Recv() (*StreamingOpenReq, error) {
 	return m, nil
 }
 
+func _Monitor_MonitorPortSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MonitorPortSettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).MonitorPortSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.monitor.Monitor/MonitorPortSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).MonitorPortSettings(ctx, req.(*MonitorPortSettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Monitor_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cc.arduino.cli.monitor.Monitor",
 	HandlerType: (*MonitorServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MonitorPortSettings",
+			Handler:    _Monitor_MonitorPortSettings_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "StreamingOpen",